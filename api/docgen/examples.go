@@ -26,7 +26,9 @@ import (
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
+	"github.com/celestiaorg/celestia-node/share/eds/cache"
 	"github.com/celestiaorg/celestia-node/state"
 )
 
@@ -56,6 +58,9 @@ var ExampleValues = map[reflect.Type]interface{}{
 	reflect.TypeOf(node.Full):                node.Full,
 	reflect.TypeOf(auth.Permission("admin")): auth.Permission("admin"),
 	reflect.TypeOf(byzantine.BadEncoding):    byzantine.BadEncoding,
+	reflect.TypeOf(blob.TxIncluded):          blob.TxIncluded,
+	reflect.TypeOf(eds.CorruptData):          eds.CorruptData,
+	reflect.TypeOf(cache.LRUPolicy):          cache.LRUPolicy,
 	reflect.TypeOf((*fraud.Proof[*header.ExtendedHeader])(nil)).Elem(): byzantine.CreateBadEncodingProof(
 		[]byte("bad encoding proof"),
 		42,
@@ -146,7 +151,11 @@ func init() {
 	addToExampleValues(generatedBlob)
 
 	proof := nmt.NewInclusionProof(0, 4, [][]byte{[]byte("test")}, true)
-	blobProof := &blob.Proof{&proof}
+	blobProof := &blob.Proof{
+		StartRow:     0,
+		SubtreeRoots: [][]byte{generatedBlob.Commitment},
+		RowProofs:    []*nmt.Proof{&proof},
+	}
 	addToExampleValues(blobProof)
 }
 
@@ -20,6 +20,12 @@ func (h *Handler) RegisterEndpoints(rpc *Server) {
 		h.handleDataByNamespaceRequest, http.MethodGet)
 	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", namespacedDataEndpoint, namespaceKey),
 		h.handleDataByNamespaceRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}/height/{%s}", rowEndpoint, indexKey, heightKey),
+		h.handleRowRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}/height/{%s}", columnEndpoint, indexKey, heightKey),
+		h.handleColumnRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/height/{%s}", odsEndpoint, heightKey),
+		h.handleODSRequest, http.MethodGet)
 
 	// DAS endpoints
 	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", heightAvailabilityEndpoint, heightKey),
@@ -4,22 +4,43 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
 )
 
 const (
 	namespacedSharesEndpoint = "/namespaced_shares"
 	namespacedDataEndpoint   = "/namespaced_data"
+	rowEndpoint              = "/row"
+	columnEndpoint           = "/column"
+	odsEndpoint              = "/ods"
 )
 
-var namespaceKey = "nid"
+// carContentType is the content type of the CARv1 encoded ODS.
+const carContentType = "application/vnd.ipld.car"
+
+var errUnorderedRanges = errors.New("gateway: ranges must be requested in the increasing order " +
+	"without overlapping")
+
+var (
+	namespaceKey = "nid"
+	indexKey     = "index"
+	fullKey      = "full"
+)
 
 // NamespacedSharesResponse represents the response to a
 // SharesByNamespace request.
@@ -35,6 +56,15 @@ type NamespacedDataResponse struct {
 	Height uint64   `json:"height"`
 }
 
+// AxisResponse represents the response to a row or column request.
+// Shares contain the first half of the row or column along with the proof
+// against its root, unless the whole row or column was requested.
+type AxisResponse struct {
+	Shares []share.Share `json:"shares"`
+	Proof  *nmt.Proof    `json:"proof,omitempty"`
+	Height uint64        `json:"height"`
+}
+
 func (h *Handler) handleSharesByNamespaceRequest(w http.ResponseWriter, r *http.Request) {
 	height, namespace, err := parseGetByNamespaceArgs(r)
 	if err != nil {
@@ -90,6 +120,94 @@ func (h *Handler) handleDataByNamespaceRequest(w http.ResponseWriter, r *http.Re
 	}
 }
 
+func (h *Handler) handleRowRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleAxisRequest(w, r, rowEndpoint, rsmt2d.Row)
+}
+
+func (h *Handler) handleColumnRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleAxisRequest(w, r, columnEndpoint, rsmt2d.Col)
+}
+
+func (h *Handler) handleAxisRequest(w http.ResponseWriter, r *http.Request, endpoint string, axis rsmt2d.Axis) {
+	idx, full, err := parseGetAxisArgs(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, endpoint, err)
+		return
+	}
+	header, err := h.performGetHeaderRequest(w, r, endpoint)
+	if err != nil {
+		// return here as we've already logged and written the error
+		return
+	}
+	half, err := h.share.GetRow(r.Context(), header, idx, axis)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, share.ErrOutOfBounds) {
+			status = http.StatusBadRequest
+		}
+		writeError(w, status, endpoint, err)
+		return
+	}
+
+	axisResp := &AxisResponse{
+		Shares: half.Shares,
+		Proof:  half.Proof,
+		Height: header.Height(),
+	}
+	if full {
+		axisResp.Shares, err = half.Extend()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, endpoint, err)
+			return
+		}
+		axisResp.Proof = nil
+	}
+	resp, err := json.Marshal(axisResp)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, endpoint, err)
+		return
+	}
+	_, err = w.Write(resp)
+	if err != nil {
+		log.Errorw("serving request", "endpoint", endpoint, "err", err)
+	}
+}
+
+// handleODSRequest streams the ODS of the given height in the CARv1 layout of the EDS store. Range
+// requests are supported, as long as the ranges are requested in the increasing order without
+// overlapping.
+func (h *Handler) handleODSRequest(w http.ResponseWriter, r *http.Request) {
+	header, err := h.performGetHeaderRequest(w, r, odsEndpoint)
+	if err != nil {
+		// return here as we've already logged and written the error
+		return
+	}
+	size, err := eds.ODSSize(header.DAH)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, odsEndpoint, err)
+		return
+	}
+	// the ODS is streamed, so the ranges can't be served by seeking backwards
+	if !rangesInOrder(r.Header.Get("Range"), size) {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+		writeError(w, http.StatusRequestedRangeNotSatisfiable, odsEndpoint, errUnorderedRanges)
+		return
+	}
+
+	// stop streaming once the requested ranges are served
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	odsCh, err := h.share.GetODS(ctx, header)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, odsEndpoint, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", carContentType)
+	w.Header().Set("Etag", fmt.Sprintf("%q", header.DataHash.String()))
+	http.ServeContent(w, r, "", time.Time{}, &chanReadSeeker{ch: odsCh, size: size})
+}
+
 func (h *Handler) getShares(ctx context.Context, height uint64, namespace share.Namespace) ([]share.Share, error) {
 	header, err := h.header.GetByHeight(ctx, height)
 	if err != nil {
@@ -104,6 +222,116 @@ func (h *Handler) getShares(ctx context.Context, height uint64, namespace share.
 	return shares.Flatten(), nil
 }
 
+// chanReadSeeker reads the chunks received from the channel. It supports seeking forward only, by
+// discarding the chunks, which is sufficient for serving the ranges in the increasing order.
+type chanReadSeeker struct {
+	ch   <-chan []byte
+	size int64
+	// pos is the position of the next read, read is the amount of bytes received from the channel
+	pos, read int64
+	chunk     []byte
+}
+
+func (r *chanReadSeeker) Read(p []byte) (int, error) {
+	if r.pos < r.read-int64(len(r.chunk)) {
+		return 0, errors.New("gateway: seeking backwards is not supported")
+	}
+
+	for {
+		// skip the bytes of the chunk before the position
+		if skip := r.pos - (r.read - int64(len(r.chunk))); skip > 0 {
+			r.chunk = r.chunk[min(skip, int64(len(r.chunk))):]
+		}
+		if len(r.chunk) > 0 {
+			n := copy(p, r.chunk)
+			r.chunk = r.chunk[n:]
+			r.pos += int64(n)
+			return n, nil
+		}
+
+		chunk, ok := <-r.ch
+		if !ok {
+			if r.read < r.size {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, io.EOF
+		}
+		r.chunk = chunk
+		r.read += int64(len(chunk))
+	}
+}
+
+func (r *chanReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("gateway: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("gateway: negative position")
+	}
+	r.pos = offset
+	return offset, nil
+}
+
+// rangesInOrder reports whether the ranges of the given Range header are in the increasing order
+// and don't overlap. Malformed and unsatisfiable ranges are left to http.ServeContent to handle.
+func rangesInOrder(rangeHeader string, size int64) bool {
+	specs, ok := strings.CutPrefix(rangeHeader, "bytes=")
+	if !ok {
+		return true
+	}
+
+	// end is the end of the previous range, exclusive
+	var end int64
+	for _, spec := range strings.Split(specs, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		first, last, ok := strings.Cut(spec, "-")
+		if !ok {
+			return true
+		}
+
+		var start, stop int64
+		if first == "" {
+			// suffix range of the last bytes
+			n, err := strconv.ParseInt(last, 10, 64)
+			if err != nil {
+				return true
+			}
+			start, stop = max(size-n, 0), size
+		} else {
+			var err error
+			start, err = strconv.ParseInt(first, 10, 64)
+			if err != nil {
+				return true
+			}
+			stop = size
+			if last != "" {
+				n, err := strconv.ParseInt(last, 10, 64)
+				if err != nil {
+					return true
+				}
+				stop = min(n+1, size)
+			}
+		}
+		if start >= size {
+			continue
+		}
+		if start < end {
+			return false
+		}
+		end = stop
+	}
+	return true
+}
+
 func dataFromShares(input []share.Share) (data [][]byte, err error) {
 	appShares, err := shares.FromBytes(input)
 	if err != nil {
@@ -139,3 +367,20 @@ func parseGetByNamespaceArgs(r *http.Request) (height uint64, namespace share.Na
 	}
 	return height, namespace, namespace.ValidateForData()
 }
+
+func parseGetAxisArgs(r *http.Request) (idx int, full bool, err error) {
+	idx, err = strconv.Atoi(mux.Vars(r)[indexKey])
+	if err != nil {
+		return 0, false, err
+	}
+	if idx < 0 {
+		return 0, false, share.ErrOutOfBounds
+	}
+	if strFull := r.URL.Query().Get(fullKey); strFull != "" {
+		full, err = strconv.ParseBool(strFull)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+	return idx, full, nil
+}
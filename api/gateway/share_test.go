@@ -1,15 +1,29 @@
 package gateway
 
 import (
+	"bytes"
+	"context"
 	_ "embed"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	coretypes "github.com/tendermint/tendermint/types"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	headerMock "github.com/celestiaorg/celestia-node/nodebuilder/header/mocks"
+	shareMock "github.com/celestiaorg/celestia-node/nodebuilder/share/mocks"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 	"github.com/celestiaorg/celestia-node/share/sharetest"
 )
 
@@ -46,3 +60,84 @@ func Test_dataFromShares(t *testing.T) {
 
 	require.Equal(t, testData, parsedSSSShares)
 }
+
+func TestODSRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	square := edstest.RandEDS(t, 8)
+	eh := headertest.ExtendedHeaderFromEDS(t, 1, square)
+
+	expected := new(bytes.Buffer)
+	require.NoError(t, eds.WriteODS(square, expected))
+
+	headerMod := headerMock.NewMockModule(ctrl)
+	headerMod.EXPECT().GetByHeight(gomock.Any(), eh.Height()).Return(eh, nil).AnyTimes()
+	shareMod := shareMock.NewMockModule(ctrl)
+	shareMod.EXPECT().GetODS(gomock.Any(), eh).DoAndReturn(
+		func(ctx context.Context, _ *header.ExtendedHeader) (<-chan []byte, error) {
+			odsCh := make(chan []byte)
+			go func() {
+				defer close(odsCh)
+				_ = eds.WriteODS(square, writerFunc(func(p []byte) (int, error) {
+					select {
+					case odsCh <- bytes.Clone(p):
+						return len(p), nil
+					case <-ctx.Done():
+						return 0, ctx.Err()
+					}
+				}))
+			}()
+			return odsCh, nil
+		}).AnyTimes()
+
+	handler := NewHandler(nil, shareMod, headerMod, nil)
+	router := mux.NewRouter()
+	router.HandleFunc(fmt.Sprintf("%s/height/{%s}", odsEndpoint, heightKey), handler.handleODSRequest)
+
+	request := func(rangeHeader string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("%s/height/%d", odsEndpoint, eh.Height()), nil)
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := request("")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, carContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, fmt.Sprint(expected.Len()), rec.Header().Get("Content-Length"))
+	assert.Equal(t, expected.Bytes(), rec.Body.Bytes())
+
+	rowR, err := eds.NewODSRowReader(rec.Body, eh.DAH)
+	require.NoError(t, err)
+	for i := 0; i < int(square.Width()/2); i++ {
+		_, _, err = rowR.Next()
+		require.NoError(t, err)
+	}
+
+	rec = request("bytes=1000-2999")
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, expected.Bytes()[1000:3000], rec.Body.Bytes())
+
+	rec = request(fmt.Sprintf("bytes=%d-", expected.Len()-10))
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, expected.Bytes()[expected.Len()-10:], rec.Body.Bytes())
+
+	rec = request("bytes=0-99,1000-1099")
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Contains(t, rec.Body.String(), string(expected.Bytes()[1000:1100]))
+
+	// the ODS can't be streamed backwards
+	for _, rangeHeader := range []string{"bytes=1000-1099,0-99", "bytes=0-999,500-1099", "bytes=-10,0-99"} {
+		rec = request(rangeHeader)
+		require.Equal(t, http.StatusRequestedRangeNotSatisfiable, rec.Code, rangeHeader)
+		assert.Equal(t, fmt.Sprintf("bytes */%d", expected.Len()), rec.Header().Get("Content-Range"))
+	}
+}
+
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
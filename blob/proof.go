@@ -0,0 +1,383 @@
+package blob
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/nmt"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// Proof proves the inclusion of a Blob into the EDS. It allows verifying both the share
+// commitment of the Blob and its inclusion into the row roots of the DataAvailabilityHeader
+// without downloading any shares.
+type Proof struct {
+	// StartRow is the index of the first row of the EDS the Blob spans.
+	StartRow int `json:"start_row"`
+	// SubtreeRoots are the roots of the subtrees over the Blob's shares the share commitment is
+	// built from, in the order they were used for the commitment.
+	SubtreeRoots [][]byte `json:"subtree_roots"`
+	// RowProofs are range proofs of the Blob's shares, one for each row the Blob spans.
+	RowProofs []*nmt.Proof `json:"row_proofs"`
+}
+
+// Len returns the amount of rows the proven Blob spans.
+func (p *Proof) Len() int { return len(p.RowProofs) }
+
+// Verify checks that the Blob identified by the given namespace and commitment is included in
+// the EDS committed to by the given root. It returns false if the Proof does not match the
+// commitment or the root, and an error if the Proof is malformed.
+func (p *Proof) Verify(root *share.Root, namespace share.Namespace, com Commitment) (bool, error) {
+	if p == nil || len(p.RowProofs) == 0 || len(p.SubtreeRoots) == 0 {
+		return false, fmt.Errorf("%w: empty proof", ErrInvalidProof)
+	}
+
+	// 1. the subtree roots have to form the share commitment
+	if !bytes.Equal(merkle.HashFromByteSlices(p.SubtreeRoots), com) {
+		return false, nil
+	}
+
+	// 2. all the subtree roots have to be under the requested namespace
+	for _, subtreeRoot := range p.SubtreeRoots {
+		if len(subtreeRoot) < 2*share.NamespaceSize ||
+			!bytes.Equal(nmt.MinNamespace(subtreeRoot, share.NamespaceSize), namespace) ||
+			!bytes.Equal(nmt.MaxNamespace(subtreeRoot, share.NamespaceSize), namespace) {
+			return false, nil
+		}
+	}
+
+	// 3. the subtree roots have to be included in the row roots
+	odsWidth := len(root.RowRoots) / 2
+	shareCount, err := p.validateLayout(odsWidth)
+	if err != nil {
+		return false, err
+	}
+
+	sizes, err := subtreeSizes(shareCount)
+	if err != nil {
+		return false, err
+	}
+	if len(sizes) != len(p.SubtreeRoots) {
+		return false, nil
+	}
+
+	hasher := nmt.NewNmtHasher(sha256.New(), share.NamespaceSize, true)
+	var cursor int
+	for i, rowProof := range p.RowProofs {
+		subtrees := make([]subtree, 0)
+		for pos := rowProof.Start(); pos < rowProof.End(); cursor++ {
+			if cursor >= len(sizes) {
+				return false, nil
+			}
+			subtrees = append(subtrees, subtree{
+				start: pos,
+				size:  sizes[cursor],
+				root:  p.SubtreeRoots[cursor],
+			})
+			pos += sizes[cursor]
+		}
+
+		rowRoot, err := computeRowRoot(hasher, rowProof.Nodes(), subtrees, 2*odsWidth)
+		if err != nil {
+			log.Debugw("recomputing row root", "row", p.StartRow+i, "err", err)
+			return false, nil
+		}
+		if !bytes.Equal(rowRoot, root.RowRoots[p.StartRow+i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// validateLayout ensures that the row proofs cover a contiguous range of shares within the
+// original data square and returns the amount of shares covered.
+func (p *Proof) validateLayout(odsWidth int) (int, error) {
+	if p.StartRow < 0 || p.StartRow+len(p.RowProofs) > odsWidth {
+		return 0, fmt.Errorf("%w: rows [%d:%d) are out of the original square of width %d",
+			ErrInvalidProof, p.StartRow, p.StartRow+len(p.RowProofs), odsWidth)
+	}
+
+	var shareCount int
+	for i, rowProof := range p.RowProofs {
+		if rowProof == nil || rowProof.Start() < 0 || rowProof.Start() >= rowProof.End() ||
+			rowProof.End() > odsWidth {
+			return 0, fmt.Errorf("%w: invalid range of row proof %d", ErrInvalidProof, i)
+		}
+		// a blob spanning multiple rows has to continue at the beginning of each next row and end
+		// at the end of each previous row
+		if i > 0 && rowProof.Start() != 0 {
+			return 0, fmt.Errorf("%w: row proof %d does not start at the row beginning", ErrInvalidProof, i)
+		}
+		if i < len(p.RowProofs)-1 && rowProof.End() != odsWidth {
+			return 0, fmt.Errorf("%w: row proof %d does not end at the row end", ErrInvalidProof, i)
+		}
+		shareCount += rowProof.End() - rowProof.Start()
+	}
+	return shareCount, nil
+}
+
+// newProof builds the Proof for the Blob, consisting of the given shares, that starts at the
+// given index of the original data square. The namespace rows and their indexes are used to
+// derive the range proofs of the Blob's shares.
+func newProof(
+	root *share.Root,
+	namespace share.Namespace,
+	namespacedShares share.NamespacedShares,
+	rowIdxs []int,
+	startIdx int,
+	blobShares []share.Share,
+) (*Proof, error) {
+	subtreeRoots, err := computeSubtreeRoots(namespace, blobShares)
+	if err != nil {
+		return nil, err
+	}
+
+	odsWidth := len(root.RowRoots) / 2
+	hasher := nmt.NewNmtHasher(sha256.New(), share.NamespaceSize, true)
+	startRow, endRow := startIdx/odsWidth, (startIdx+len(blobShares)-1)/odsWidth
+	proof := &Proof{
+		StartRow:     startRow,
+		SubtreeRoots: subtreeRoots,
+		RowProofs:    make([]*nmt.Proof, 0, endRow-startRow+1),
+	}
+
+	for row := startRow; row <= endRow; row++ {
+		from, to := 0, odsWidth
+		if row == startRow {
+			from = startIdx % odsWidth
+		}
+		if row == endRow {
+			to = (startIdx+len(blobShares)-1)%odsWidth + 1
+		}
+
+		nsRow, err := namespacedRow(namespacedShares, rowIdxs, row)
+		if err != nil {
+			return nil, err
+		}
+
+		rowProof, err := narrowRowProof(hasher, nsRow, namespace, from, to, 2*odsWidth)
+		if err != nil {
+			return nil, fmt.Errorf("building proof for row %d: %w", row, err)
+		}
+		proof.RowProofs = append(proof.RowProofs, rowProof)
+	}
+	return proof, nil
+}
+
+// namespacedRow returns the namespaced row of the given EDS row index.
+func namespacedRow(namespacedShares share.NamespacedShares, rowIdxs []int, row int) (share.NamespacedRow, error) {
+	for i, idx := range rowIdxs {
+		if idx == row {
+			return namespacedShares[i], nil
+		}
+	}
+	return share.NamespacedRow{}, fmt.Errorf("row %d is not found in namespaced shares", row)
+}
+
+// narrowRowProof converts the namespace proof of the row into the range proof of [from:to), which
+// has to be within the namespace range. Proof nodes within the namespace range are recomputed from
+// the row's shares.
+func narrowRowProof(
+	hasher *nmt.NmtHasher,
+	nsRow share.NamespacedRow,
+	namespace share.Namespace,
+	from, to, width int,
+) (*nmt.Proof, error) {
+	nsFrom, nsTo := nsRow.Proof.Start(), nsRow.Proof.End()
+	if from < nsFrom || to > nsTo || nsTo-nsFrom != len(nsRow.Shares) {
+		return nil, fmt.Errorf("range [%d:%d) is out of the namespace range [%d:%d)", from, to, nsFrom, nsTo)
+	}
+
+	leafHashes := make([][]byte, len(nsRow.Shares))
+	for i, shr := range nsRow.Shares {
+		leaf, err := hasher.HashLeaf(nmtLeaf(namespace, shr))
+		if err != nil {
+			return nil, err
+		}
+		leafHashes[i] = leaf
+	}
+
+	nsNodes := nsRow.Proof.Nodes()
+	// hashSubtree computes the hash of the subtree [start:end) using the namespace proof nodes for
+	// subtrees outside the namespace range and shares for the ones within it
+	var hashSubtree func(start, end int) ([]byte, error)
+	hashSubtree = func(start, end int) ([]byte, error) {
+		if end <= nsFrom || start >= nsTo {
+			if len(nsNodes) == 0 {
+				return nil, errors.New("namespace proof has not enough nodes")
+			}
+			node := nsNodes[0]
+			nsNodes = nsNodes[1:]
+			return node, nil
+		}
+		if end-start == 1 {
+			return leafHashes[start-nsFrom], nil
+		}
+
+		split := start + splitPoint(end-start)
+		left, err := hashSubtree(start, split)
+		if err != nil {
+			return nil, err
+		}
+		right, err := hashSubtree(split, end)
+		if err != nil {
+			return nil, err
+		}
+		return hasher.HashNode(left, right)
+	}
+
+	nodes := make([][]byte, 0)
+	var collect func(start, end int) error
+	collect = func(start, end int) error {
+		switch {
+		case end <= from || start >= to:
+			node, err := hashSubtree(start, end)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, node)
+			return nil
+		case start >= from && end <= to:
+			// the subtree is fully covered by the range, so it is not a part of the proof
+			return nil
+		}
+
+		split := start + splitPoint(end-start)
+		if err := collect(start, split); err != nil {
+			return err
+		}
+		return collect(split, end)
+	}
+
+	if err := collect(0, width); err != nil {
+		return nil, err
+	}
+	proof := nmt.NewInclusionProof(from, to, nodes, true)
+	return &proof, nil
+}
+
+// subtree is a root of the subtree over the shares [start:start+size) of the row.
+type subtree struct {
+	start, size int
+	root        []byte
+}
+
+// computeRowRoot recomputes the root of the row from the range proof nodes and the subtree roots
+// covering the proven range. Subtree roots have to be aligned with the inner nodes of the row.
+func computeRowRoot(hasher *nmt.NmtHasher, nodes [][]byte, subtrees []subtree, width int) ([]byte, error) {
+	if len(subtrees) == 0 {
+		return nil, errors.New("no subtree roots provided")
+	}
+	last := subtrees[len(subtrees)-1]
+	from, to := subtrees[0].start, last.start+last.size
+
+	var compute func(start, end int) ([]byte, error)
+	compute = func(start, end int) ([]byte, error) {
+		if end <= from || start >= to {
+			if len(nodes) == 0 {
+				return nil, errors.New("proof has not enough nodes")
+			}
+			node := nodes[0]
+			nodes = nodes[1:]
+			return node, nil
+		}
+
+		if start >= from && end <= to {
+			for _, st := range subtrees {
+				if st.start == start && st.size == end-start {
+					return st.root, nil
+				}
+			}
+			if end-start == 1 {
+				return nil, fmt.Errorf("subtree roots are not aligned with the row at index %d", start)
+			}
+		}
+
+		split := start + splitPoint(end-start)
+		left, err := compute(start, split)
+		if err != nil {
+			return nil, err
+		}
+		right, err := compute(split, end)
+		if err != nil {
+			return nil, err
+		}
+		return hasher.HashNode(left, right)
+	}
+
+	root, err := compute(0, width)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) != 0 {
+		return nil, fmt.Errorf("proof has %d redundant nodes", len(nodes))
+	}
+	return root, nil
+}
+
+// computeSubtreeRoots computes the roots of the subtrees the share commitment of the Blob is
+// built from. It mirrors the share commitment creation of celestia-app.
+func computeSubtreeRoots(ns share.Namespace, blobShares []share.Share) ([][]byte, error) {
+	sizes, err := subtreeSizes(len(blobShares))
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([][]byte, 0, len(sizes))
+	var cursor int
+	for _, size := range sizes {
+		tree := nmt.New(sha256.New(), nmt.NamespaceIDSize(share.NamespaceSize), nmt.IgnoreMaxNamespace(true))
+		for _, shr := range blobShares[cursor : cursor+size] {
+			if err := tree.Push(nmtLeaf(ns, shr)); err != nil {
+				return nil, err
+			}
+		}
+		root, err := tree.Root()
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+		cursor += size
+	}
+	return roots, nil
+}
+
+// subtreeSizes returns the sizes of the subtrees the share commitment of a Blob with the given
+// amount of shares is built from.
+func subtreeSizes(shareCount int) ([]int, error) {
+	if shareCount <= 0 {
+		return nil, fmt.Errorf("%w: blob has no shares", ErrInvalidProof)
+	}
+
+	maxSize := shares.SubTreeWidth(shareCount, appconsts.DefaultSubtreeRootThreshold)
+	sizes := make([]int, 0)
+	for shareCount > 0 {
+		size := maxSize
+		if shareCount < maxSize {
+			size = 1 << (bits.Len(uint(shareCount)) - 1)
+		}
+		sizes = append(sizes, size)
+		shareCount -= size
+	}
+	return sizes, nil
+}
+
+// nmtLeaf prepends the namespace to the share, the same way the row trees do.
+func nmtLeaf(ns share.Namespace, shr share.Share) []byte {
+	leaf := make([]byte, 0, len(ns)+len(shr))
+	leaf = append(leaf, ns...)
+	return append(leaf, shr...)
+}
+
+// splitPoint returns the size of the left subtree of a tree with the given amount of leaves.
+func splitPoint(length int) int {
+	return 1 << (bits.Len(uint(length-1)) - 1)
+}
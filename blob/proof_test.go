@@ -0,0 +1,109 @@
+package blob
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/go-header/store"
+
+	"github.com/celestiaorg/celestia-node/blob/blobtest"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/getters"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+// TestProof_VerifyMultiRow ensures that a Blob spanning multiple rows, with subtrees wider than
+// a single share, can be verified against the DataAvailabilityHeader only.
+func TestProof_VerifyMultiRow(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	const odsWidth = 16
+	appBlobs, err := blobtest.GenerateV0Blobs([]int{200}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(appBlobs...)
+	require.NoError(t, err)
+	large := blobs[0]
+	largeShares, err := BlobsToShares(large)
+	require.NoError(t, err)
+
+	// the small blob has to precede the large one in the square
+	smallNs, err := share.NewBlobNamespaceV0([]byte{0, 0, 0, 0, 0, 1, 0})
+	require.NoError(t, err)
+	small, err := NewBlobV0(smallNs, []byte("small blob"))
+	require.NoError(t, err)
+	smallShares, err := BlobsToShares(small)
+	require.NoError(t, err)
+
+	// the large blob has to start at the index aligned to its subtree width, the same way the
+	// square builder places it
+	width := shares.SubTreeWidth(len(largeShares), appconsts.DefaultSubtreeRootThreshold)
+	require.Greater(t, width, 1)
+	padding, err := shares.NamespacePaddingShares(small.Namespace().ToAppNamespace(),
+		appconsts.ShareVersionZero, width-len(smallShares))
+	require.NoError(t, err)
+
+	rawShares := append([][]byte{}, smallShares...)
+	rawShares = append(rawShares, shares.ToBytes(padding)...)
+	rawShares = append(rawShares, largeShares...)
+	rawShares = append(rawShares, shares.ToBytes(shares.TailPaddingShares(odsWidth*odsWidth-len(rawShares)))...)
+
+	bs := ipld.NewMemBlockservice()
+	headerStore, err := store.NewStore[*header.ExtendedHeader](ds_sync.MutexWrap(ds.NewMapDatastore()))
+	require.NoError(t, err)
+	eds, err := ipld.AddShares(ctx, rawShares, bs)
+	require.NoError(t, err)
+	h := headertest.ExtendedHeaderFromEDS(t, 1, eds)
+	require.NoError(t, headerStore.Init(ctx, h))
+
+	service := NewService(nil, getters.NewIPLDGetter(bs), headerStore.GetByHeight, nil)
+
+	proof, err := service.GetProof(ctx, 1, large.Namespace(), large.Commitment)
+	require.NoError(t, err)
+	require.Greater(t, proof.Len(), 1)
+
+	included, err := proof.Verify(h.DAH, large.Namespace(), large.Commitment)
+	require.NoError(t, err)
+	require.True(t, included)
+
+	included, err = service.Included(ctx, 1, large.Namespace(), proof, large.Commitment)
+	require.NoError(t, err)
+	require.True(t, included)
+
+	// proof of another blob can't prove the commitment
+	smallProof, err := service.GetProof(ctx, 1, small.Namespace(), small.Commitment)
+	require.NoError(t, err)
+	included, err = smallProof.Verify(h.DAH, large.Namespace(), large.Commitment)
+	require.NoError(t, err)
+	require.False(t, included)
+
+	// tampered subtree roots don't match the commitment
+	tampered := *proof
+	tampered.SubtreeRoots = append([][]byte{}, proof.SubtreeRoots...)
+	tampered.SubtreeRoots[0], tampered.SubtreeRoots[1] = tampered.SubtreeRoots[1], tampered.SubtreeRoots[0]
+	included, err = tampered.Verify(h.DAH, large.Namespace(), large.Commitment)
+	require.NoError(t, err)
+	require.False(t, included)
+
+	// shifted rows don't match the row roots
+	tampered = *proof
+	tampered.StartRow++
+	included, err = tampered.Verify(h.DAH, large.Namespace(), large.Commitment)
+	require.NoError(t, err)
+	require.False(t, included)
+
+	// the proof can't reference rows outside the original square
+	tampered = *proof
+	tampered.StartRow = odsWidth
+	_, err = tampered.Verify(h.DAH, large.Namespace(), large.Commitment)
+	require.ErrorIs(t, err, ErrInvalidProof)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,14 @@ import (
 
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+const (
+	// subscriptionBufferSize is the amount of responses buffered for a blob subscriber.
+	subscriptionBufferSize = 16
+	// submitStatusPollInterval is the interval between checks of the submitted transaction status.
+	submitStatusPollInterval = time.Second
 )
 
 var (
@@ -27,7 +36,52 @@ var (
 // avoid a circular dependency between the blob and the state package, since the state package needs
 // the blob.Blob type for this signature.
 type Submitter interface {
-	SubmitPayForBlob(ctx context.Context, fee math.Int, gasLim uint64, blobs []*Blob) (*types.TxResponse, error)
+	// SubmitPayForBlobWithAccount submits blobs signed by the given account and waits for the
+	// transaction to be committed.
+	SubmitPayForBlobWithAccount(
+		ctx context.Context,
+		fee math.Int,
+		gasLim uint64,
+		blobs []*Blob,
+		account string,
+	) (*types.TxResponse, error)
+	// SubmitPayForBlobAsync submits blobs without waiting for the transaction to be committed.
+	SubmitPayForBlobAsync(
+		ctx context.Context,
+		fee math.Int,
+		gasLim uint64,
+		blobs []*Blob,
+		account string,
+	) (*types.TxResponse, error)
+	// TxStatus returns the response of the committed transaction or nil if it is not committed yet.
+	TxStatus(ctx context.Context, txHash string) (*types.TxResponse, error)
+	// SquareParams returns the maximum square size the chain allows and the version of the app.
+	SquareParams(ctx context.Context) (int, uint64, error)
+}
+
+// TxState is the state of the submitted PayForBlob transaction.
+type TxState string
+
+const (
+	// TxPending means that the transaction is not committed yet.
+	TxPending TxState = "pending"
+	// TxIncluded means that the transaction is committed successfully.
+	TxIncluded TxState = "included"
+	// TxFailed means that the transaction is committed, but its execution failed.
+	TxFailed TxState = "failed"
+)
+
+// SubmitStatus describes the status of the asynchronously submitted blobs.
+type SubmitStatus struct {
+	TxHash string  `json:"tx_hash"`
+	State  TxState `json:"state"`
+	// Height is the height the blobs were included at. It is only set for the included state.
+	Height uint64 `json:"height,omitempty"`
+	// Code and Codespace are the ABCI code of the failed transaction.
+	Code      uint32 `json:"code,omitempty"`
+	Codespace string `json:"codespace,omitempty"`
+	// Log is the raw log of the failed transaction.
+	Log string `json:"log,omitempty"`
 }
 
 type Service struct {
@@ -37,17 +91,21 @@ type Service struct {
 	shareGetter share.Getter
 	// headerGetter fetches header by the provided height
 	headerGetter func(context.Context, uint64) (*header.ExtendedHeader, error)
+	// headerSub subscribes to new headers from the network.
+	headerSub func(context.Context) (<-chan *header.ExtendedHeader, error)
 }
 
 func NewService(
 	submitter Submitter,
 	getter share.Getter,
 	headerGetter func(context.Context, uint64) (*header.ExtendedHeader, error),
+	headerSub func(context.Context) (<-chan *header.ExtendedHeader, error),
 ) *Service {
 	return &Service{
 		blobSubmitter: submitter,
 		shareGetter:   getter,
 		headerGetter:  headerGetter,
+		headerSub:     headerSub,
 	}
 }
 
@@ -56,6 +114,9 @@ func NewService(
 type SubmitOptions struct {
 	Fee      int64
 	GasLimit uint64
+	// Account is the key name or the address of the node's keyring account that pays for the
+	// blobs. The default account of the node is used if it is empty.
+	Account string
 }
 
 // DefaultSubmitOptions creates a default fee and gas price values.
@@ -68,7 +129,7 @@ func DefaultSubmitOptions() *SubmitOptions {
 
 // Submit sends PFB transaction and reports the height in which it was included.
 // Allows sending multiple Blobs atomically synchronously.
-// Uses default wallet registered on the Node.
+// Uses default wallet registered on the Node, unless another account is set in the options.
 // Handles gas estimation and fee calculation.
 func (s *Service) Submit(ctx context.Context, blobs []*Blob, options *SubmitOptions) (uint64, error) {
 	log.Debugw("submitting blobs", "amount", len(blobs))
@@ -77,11 +138,80 @@ func (s *Service) Submit(ctx context.Context, blobs []*Blob, options *SubmitOpti
 		options = DefaultSubmitOptions()
 	}
 
-	resp, err := s.blobSubmitter.SubmitPayForBlob(ctx, types.NewInt(options.Fee), options.GasLimit, blobs)
+	height, _, err := s.submit(ctx, blobs, options)
+	return height, err
+}
+
+// SubmitAsync sends PFB transaction and returns its hash once it is accepted into the mempool,
+// without waiting for it to be included into a block. The status of the submission can be tracked
+// with SubmitStatus or WaitSubmitted.
+// Uses default wallet registered on the Node, unless another account is set in the options.
+// Handles gas estimation and fee calculation.
+func (s *Service) SubmitAsync(ctx context.Context, blobs []*Blob, options *SubmitOptions) (string, error) {
+	log.Debugw("submitting blobs asynchronously", "amount", len(blobs))
+
+	if options == nil {
+		options = DefaultSubmitOptions()
+	}
+
+	resp, err := s.blobSubmitter.SubmitPayForBlobAsync(
+		ctx,
+		types.NewInt(options.Fee),
+		options.GasLimit,
+		blobs,
+		options.Account,
+	)
 	if err != nil {
-		return 0, err
+		return "", err
+	}
+	return resp.TxHash, nil
+}
+
+// SubmitStatus reports the status of the blobs submitted within the transaction with the given
+// hash. Transactions that are unknown to the network, e.g. evicted from the mempool, are reported
+// as pending.
+func (s *Service) SubmitStatus(ctx context.Context, txHash string) (*SubmitStatus, error) {
+	resp, err := s.blobSubmitter.TxStatus(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &SubmitStatus{TxHash: txHash, State: TxPending}
+	switch {
+	case resp == nil:
+	case resp.Code != 0:
+		status.State = TxFailed
+		status.Code = resp.Code
+		status.Codespace = resp.Codespace
+		status.Log = resp.RawLog
+	default:
+		status.State = TxIncluded
+		status.Height = uint64(resp.Height)
+	}
+	return status, nil
+}
+
+// WaitSubmitted blocks until the transaction with the given hash is committed and reports the
+// status of the submitted blobs. Use the context to bound the waiting.
+func (s *Service) WaitSubmitted(ctx context.Context, txHash string) (*SubmitStatus, error) {
+	ticker := time.NewTicker(submitStatusPollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := s.SubmitStatus(ctx, txHash)
+		if err != nil {
+			return nil, err
+		}
+		if status.State != TxPending {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
 	}
-	return uint64(resp.Height), nil
 }
 
 // Get retrieves all the blobs for given namespaces at the given height by commitment.
@@ -155,10 +285,109 @@ func (s *Service) GetAll(ctx context.Context, height uint64, namespaces []share.
 	return blobs, errors.Join(resultErr...)
 }
 
+// SubscriptionResponse is the result of the blob subscription for a single height.
+type SubscriptionResponse struct {
+	// Blobs are all the blobs under the subscribed namespace at the Height. It is empty if there
+	// are no blobs under the namespace.
+	Blobs  []*Blob                `json:"blobs"`
+	Height uint64                 `json:"height"`
+	Header *header.ExtendedHeader `json:"header"`
+}
+
+// Subscribe streams blobs under the given namespace for every new height in order. The stream
+// starts from the given height, allowing to resume a previous subscription, or from the next
+// height received from the network if fromHeight is 0. A height is reported even if there are
+// no blobs under the namespace.
+// Heights are never skipped: if the subscriber does not keep up, fetching of blobs pauses until
+// it reads the buffered responses. The channel is closed once the context is canceled or blobs
+// of a height can't be retrieved; the subscription can be resumed from the height following the
+// last received one.
+func (s *Service) Subscribe(
+	ctx context.Context,
+	namespace share.Namespace,
+	fromHeight uint64,
+) (<-chan *SubscriptionResponse, error) {
+	if err := namespace.ValidateForBlob(); err != nil {
+		return nil, err
+	}
+
+	headerCh, err := s.headerSub(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("subscribing to headers: %w", err)
+	}
+
+	respCh := make(chan *SubscriptionResponse, subscriptionBufferSize)
+	go s.subscribe(ctx, namespace, fromHeight, headerCh, respCh)
+	return respCh, nil
+}
+
+// subscribe sends blobs of all the heights starting from the given one to the response channel
+// as new headers arrive. Heights between the received headers are fetched from the header getter.
+func (s *Service) subscribe(
+	ctx context.Context,
+	namespace share.Namespace,
+	next uint64,
+	headerCh <-chan *header.ExtendedHeader,
+	respCh chan<- *SubscriptionResponse,
+) {
+	defer close(respCh)
+
+	for {
+		var head *header.ExtendedHeader
+		select {
+		case <-ctx.Done():
+			return
+		case h, ok := <-headerCh:
+			if !ok {
+				return
+			}
+			head = h
+		}
+
+		if next == 0 {
+			next = head.Height()
+		}
+
+		for ; next <= head.Height(); next++ {
+			eh := head
+			if next != head.Height() {
+				var err error
+				eh, err = s.headerGetter(ctx, next)
+				if err != nil {
+					if ctx.Err() == nil {
+						log.Errorw("subscription: getting header", "height", next, "err", err)
+					}
+					return
+				}
+			}
+
+			blobs, err := s.getBlobs(ctx, namespace, eh)
+			switch {
+			case errors.Is(err, ErrBlobNotFound), errors.Is(err, share.ErrNotFound):
+				blobs = []*Blob{}
+			case err != nil:
+				if ctx.Err() == nil {
+					log.Errorw("subscription: getting blobs", "height", next, "namespace", namespace.String(),
+						"err", err)
+				}
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case respCh <- &SubscriptionResponse{Blobs: blobs, Height: next, Header: eh}:
+			}
+		}
+	}
+}
+
 // Included verifies that the blob was included in a specific height.
 // To ensure that blob was included in a specific height, we need:
-// 1. verify the provided commitment by recomputing it;
-// 2. verify the provided Proof against subtree roots that were used in 1.;
+// 1. verify the provided commitment by recomputing it from the subtree roots of the Proof;
+// 2. verify the subtree roots against the row roots of the header at the given height;
+// The verification only relies on the locally available header and does not perform any network
+// requests.
 func (s *Service) Included(
 	ctx context.Context,
 	height uint64,
@@ -166,28 +395,16 @@ func (s *Service) Included(
 	proof *Proof,
 	com Commitment,
 ) (bool, error) {
-	// In the current implementation, LNs will have to download all shares to recompute the commitment.
-	// To achieve 1. we need to modify Proof structure and to store all subtree roots, that were
-	// involved in commitment creation and then call `merkle.HashFromByteSlices`(tendermint package).
-	// nmt.Proof is verifying share inclusion by recomputing row roots, so, theoretically, we can do
-	// the same but using subtree roots. For this case, we need an extra method in nmt.Proof
-	// that will perform all reconstructions,
-	// but we have to guarantee that all our stored subtree roots will be on the same height(e.g. one
-	// level above shares).
-	// TODO(@vgonkivs): rework the implementation to perform all verification without network requests.
-	_, resProof, err := s.getByCommitment(ctx, height, namespace, com)
-	switch err {
-	case nil:
-	case ErrBlobNotFound:
-		return false, nil
-	default:
+	header, err := s.headerGetter(ctx, height)
+	if err != nil {
 		return false, err
 	}
-	return true, resProof.equal(*proof)
+	return proof.Verify(header.DAH, namespace, com)
 }
 
-// getByCommitment retrieves the DAH row by row, fetching shares and constructing blobs in order to
-// compare Commitments. Retrieving is stopped once the requested blob/proof is found.
+// getByCommitment retrieves all the shares of the namespace and reconstructs blobs one by one in
+// order to compare Commitments. Reconstruction is stopped once the requested blob is found, and
+// its Proof is built out of the namespace proofs.
 func (s *Service) getByCommitment(
 	ctx context.Context,
 	height uint64,
@@ -211,56 +428,70 @@ func (s *Service) getByCommitment(
 		return nil, nil, err
 	}
 
+	rowIdxs := ipld.FilterRowIndexesByNamespace(header.DAH, namespace)
+	if len(rowIdxs) != len(namespacedShares) {
+		return nil, nil, fmt.Errorf("amount of rows differs between root and namespace shares: "+
+			"expected %d, got %d", len(rowIdxs), len(namespacedShares))
+	}
+
+	// collect all the shares of the namespace together with their indexes in the original square
 	var (
-		rawShares = make([]shares.Share, 0)
-		proofs    = make(Proof, 0)
-		// spansMultipleRows specifies whether blob is expanded into multiple rows
-		spansMultipleRows bool
+		odsWidth  = len(header.DAH.RowRoots) / 2
+		rawShares = make([]share.Share, 0)
+		indexes   = make([]int, 0)
 	)
+	for i, row := range namespacedShares {
+		for j, shr := range row.Shares {
+			rawShares = append(rawShares, shr)
+			indexes = append(indexes, rowIdxs[i]*odsWidth+row.Proof.Start()+j)
+		}
+	}
 
-	for _, row := range namespacedShares {
-		appShares, err := toAppShares(row.Shares...)
+	for i := 0; i < len(rawShares); {
+		appShare, err := shares.NewShare(rawShares[i])
 		if err != nil {
 			return nil, nil, err
 		}
-		rawShares = append(rawShares, appShares...)
-		proofs = append(proofs, row.Proof)
 
-		var blobs []*Blob
-		blobs, rawShares, err = buildBlobsIfExist(rawShares)
+		isPadding, err := appShare.IsPadding()
 		if err != nil {
 			return nil, nil, err
 		}
-		for _, b := range blobs {
-			if b.Commitment.Equal(commitment) {
-				return b, &proofs, nil
-			}
-			// Falling under this flag means that the data from the last row
-			// was insufficient to create a complete blob. As a result,
-			// the first blob received spans two rows and includes proofs
-			// for both of these rows. All other blobs in the result will relate
-			// to the current row and have a single proof.
-			if spansMultipleRows {
-				spansMultipleRows = false
-				// leave proof only for the current row
-				proofs = proofs[len(proofs)-1:]
-			}
+		if isPadding {
+			i++
+			continue
 		}
 
-		if len(rawShares) > 0 {
-			spansMultipleRows = true
-			continue
+		length, err := appShare.SequenceLen()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		amount := shares.SparseSharesNeeded(length)
+		if i+amount > len(rawShares) {
+			err = fmt.Errorf("incomplete blob with the "+
+				"namespace: %s detected at %d: %w", namespace.String(), height, ErrBlobNotFound)
+			log.Error(err)
+			return nil, nil, err
 		}
-		proofs = nil
-	}
 
-	err = ErrBlobNotFound
-	if len(rawShares) > 0 {
-		err = fmt.Errorf("incomplete blob with the "+
-			"namespace: %s detected at %d: %w", namespace.String(), height, err)
-		log.Error(err)
+		blobShares := rawShares[i : i+amount]
+		blobs, err := SharesToBlobs(blobShares)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// only 1 blob will be created bc we passed the exact amount of shares
+		if blobs[0].Commitment.Equal(commitment) {
+			proof, err := newProof(header.DAH, namespace, namespacedShares, rowIdxs, indexes[i], blobShares)
+			if err != nil {
+				return nil, nil, fmt.Errorf("building blob proof: %w", err)
+			}
+			return blobs[0], proof, nil
+		}
+		i += amount
 	}
-	return nil, nil, err
+	return nil, nil, ErrBlobNotFound
 }
 
 // getBlobs retrieves the DAH and fetches all shares from the requested Namespace and converts
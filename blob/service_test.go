@@ -7,17 +7,27 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types"
 	ds "github.com/ipfs/go-datastore"
 	ds_sync "github.com/ipfs/go-datastore/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/celestiaorg/go-header/store"
 
 	"github.com/celestiaorg/celestia-node/blob/blobtest"
@@ -161,7 +171,7 @@ func TestBlobService_Get(t *testing.T) {
 				verifyFn := func(t *testing.T, rawShares [][]byte, proof *Proof, namespace share.Namespace) {
 					for _, row := range header.DAH.RowRoots {
 						to := 0
-						for _, p := range *proof {
+						for _, p := range proof.RowProofs {
 							from := to
 							to = p.End() - p.Start() + from
 							eq := p.VerifyInclusion(sha256.New(), namespace.ToNMT(), rawShares[from:to], row)
@@ -200,11 +210,10 @@ func TestBlobService_Get(t *testing.T) {
 				return service.Included(ctx, 1, blobs0[0].Namespace(), proof, blobs0[0].Commitment)
 			},
 			expectedResult: func(res interface{}, err error) {
-				require.Error(t, err)
-				require.ErrorIs(t, err, ErrInvalidProof)
+				require.NoError(t, err)
 				included, ok := res.(bool)
 				require.True(t, ok)
-				require.True(t, included)
+				require.False(t, included)
 			},
 		},
 		{
@@ -285,7 +294,20 @@ func TestBlobService_Get(t *testing.T) {
 
 				newProof, err := service.GetProof(ctx, 1, blobs0[1].Namespace(), blobs0[1].Commitment)
 				require.NoError(t, err)
-				require.NoError(t, proof.equal(*newProof))
+				require.Equal(t, newProof.StartRow, proof.StartRow)
+				require.Equal(t, newProof.SubtreeRoots, proof.SubtreeRoots)
+				require.Equal(t, newProof.Len(), proof.Len())
+				for i, rowProof := range newProof.RowProofs {
+					require.Equal(t, rowProof.Start(), proof.RowProofs[i].Start())
+					require.Equal(t, rowProof.End(), proof.RowProofs[i].End())
+					require.Equal(t, rowProof.Nodes(), proof.RowProofs[i].Nodes())
+				}
+
+				h, err := service.headerGetter(ctx, 1)
+				require.NoError(t, err)
+				included, err := proof.Verify(h.DAH, blobs0[1].Namespace(), blobs0[1].Commitment)
+				require.NoError(t, err)
+				require.True(t, included)
 			},
 		},
 	}
@@ -339,7 +361,7 @@ func TestService_GetSingleBlobWithoutPadding(t *testing.T) {
 	fn := func(ctx context.Context, height uint64) (*header.ExtendedHeader, error) {
 		return headerStore.GetByHeight(ctx, height)
 	}
-	service := NewService(nil, getters.NewIPLDGetter(bs), fn)
+	service := NewService(nil, getters.NewIPLDGetter(bs), fn, nil)
 
 	newBlob, err := service.Get(ctx, 1, blobs[1].Namespace(), blobs[1].Commitment)
 	require.NoError(t, err)
@@ -410,7 +432,7 @@ func TestService_GetAllWithoutPadding(t *testing.T) {
 		return headerStore.GetByHeight(ctx, height)
 	}
 
-	service := NewService(nil, getters.NewIPLDGetter(bs), fn)
+	service := NewService(nil, getters.NewIPLDGetter(bs), fn, nil)
 
 	_, err = service.GetAll(ctx, 1, []share.Namespace{blobs[0].Namespace(), blobs[1].Namespace()})
 	require.NoError(t, err)
@@ -433,5 +455,270 @@ func createService(ctx context.Context, t *testing.T, blobs []*Blob) *Service {
 	fn := func(ctx context.Context, height uint64) (*header.ExtendedHeader, error) {
 		return headerStore.GetByHeight(ctx, height)
 	}
-	return NewService(nil, getters.NewIPLDGetter(bs), fn)
+	return NewService(nil, getters.NewIPLDGetter(bs), fn, nil)
+}
+
+func TestService_Subscribe(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	appBlobs, err := blobtest.GenerateV0Blobs([]int{4, 6}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(appBlobs...)
+	require.NoError(t, err)
+
+	bs := ipld.NewMemBlockservice()
+	// the first and the last heights contain the first blob, while the middle one does not
+	heights := [][]*Blob{{blobs[0]}, {blobs[1]}, {blobs[0], blobs[1]}}
+	headers := make(map[uint64]*header.ExtendedHeader)
+	for i, heightBlobs := range heights {
+		rawShares, err := BlobsToShares(heightBlobs...)
+		require.NoError(t, err)
+		rawShares = append(rawShares, shares.ToBytes(shares.TailPaddingShares(16-len(rawShares)))...)
+		eds, err := ipld.AddShares(ctx, rawShares, bs)
+		require.NoError(t, err)
+		headers[uint64(i+1)] = headertest.ExtendedHeaderFromEDS(t, uint64(i+1), eds)
+	}
+
+	headerCh := make(chan *header.ExtendedHeader)
+	service := NewService(
+		nil,
+		getters.NewIPLDGetter(bs),
+		func(_ context.Context, height uint64) (*header.ExtendedHeader, error) {
+			return headers[height], nil
+		},
+		func(context.Context) (<-chan *header.ExtendedHeader, error) {
+			return headerCh, nil
+		},
+	)
+
+	_, err = service.Subscribe(ctx, share.Namespace(tmrand.Bytes(share.NamespaceSize)), 0)
+	require.Error(t, err)
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	respCh, err := service.Subscribe(subCtx, blobs[0].Namespace(), 1)
+	require.NoError(t, err)
+
+	// only the latest header is received from the network, while the previous ones have to be
+	// fetched to resume from the requested height
+	headerCh <- headers[3]
+	for height := uint64(1); height <= 3; height++ {
+		select {
+		case resp := <-respCh:
+			require.Equal(t, height, resp.Height)
+			require.Equal(t, headers[height], resp.Header)
+			if height == 2 {
+				require.Len(t, resp.Blobs, 0)
+				continue
+			}
+			require.Len(t, resp.Blobs, 1)
+			require.True(t, resp.Blobs[0].Commitment.Equal(blobs[0].Commitment))
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for subscription response")
+		}
+	}
+
+	subCancel()
+	select {
+	case _, ok := <-respCh:
+		require.False(t, ok)
+	case <-ctx.Done():
+		t.Fatal("subscription is not closed")
+	}
+}
+
+func TestService_SubmitStatus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	submitter := &submitterStub{txs: make(map[string]*types.TxResponse)}
+	service := NewService(submitter, nil, nil, nil)
+
+	appBlobs, err := blobtest.GenerateV0Blobs([]int{1}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(appBlobs...)
+	require.NoError(t, err)
+
+	txHash, err := service.SubmitAsync(ctx, blobs, nil)
+	require.NoError(t, err)
+
+	status, err := service.SubmitStatus(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, &SubmitStatus{TxHash: txHash, State: TxPending}, status)
+
+	// the transaction gets included while the caller waits for it
+	go func() {
+		time.Sleep(submitStatusPollInterval / 2)
+		submitter.commit(txHash, &types.TxResponse{Height: 42})
+	}()
+	status, err = service.WaitSubmitted(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, &SubmitStatus{TxHash: txHash, State: TxIncluded, Height: 42}, status)
+
+	txHash, err = service.SubmitAsync(ctx, blobs, nil)
+	require.NoError(t, err)
+	submitter.commit(txHash, &types.TxResponse{Height: 43, Code: 11, Codespace: "sdk", RawLog: "out of gas"})
+	status, err = service.WaitSubmitted(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, &SubmitStatus{
+		TxHash:    txHash,
+		State:     TxFailed,
+		Code:      11,
+		Codespace: "sdk",
+		Log:       "out of gas",
+	}, status)
+
+	// waiting is bounded by the context
+	txHash, err = service.SubmitAsync(ctx, blobs, nil)
+	require.NoError(t, err)
+	waitCtx, waitCancel := context.WithTimeout(ctx, submitStatusPollInterval/2)
+	t.Cleanup(waitCancel)
+	_, err = service.WaitSubmitted(waitCtx, txHash)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestService_SubmitSplit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	submitter := &submitterStub{txs: make(map[string]*types.TxResponse)}
+	service := NewService(submitter, nil, nil, nil)
+
+	// every pair of the large blobs fills most of the square
+	appBlobs, err := blobtest.GenerateV0Blobs([]int{1700, 1700, 1700, 10}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(appBlobs...)
+	require.NoError(t, err)
+	// the blob of the max size doesn't fit into the square along with its PFB transaction
+	tooLarge, err := NewBlobV0(blobs[0].Namespace(), tmrand.Bytes(appconsts.DefaultMaxBytes))
+	require.NoError(t, err)
+	blobs = append(blobs[:2], append([]*Blob{tooLarge}, blobs[2:]...)...)
+
+	results, err := service.SubmitSplit(ctx, blobs, nil)
+	require.NoError(t, err)
+	require.Len(t, results, len(blobs))
+	require.Len(t, submitter.submitted, 2)
+
+	for i, result := range results {
+		require.True(t, result.Commitment.Equal(blobs[i].Commitment))
+		if i == 2 {
+			require.Equal(t, ErrBlobTooLarge.Error(), result.Error)
+			require.Empty(t, result.TxHash)
+			continue
+		}
+		require.Empty(t, result.Error)
+		require.NotEmpty(t, result.TxHash)
+		require.NotZero(t, result.Height)
+	}
+	// the blobs are batched in order and the blobs of the same batch share the transaction
+	require.Equal(t, results[0].TxHash, results[1].TxHash)
+	require.Equal(t, results[3].TxHash, results[4].TxHash)
+	require.NotEqual(t, results[0].TxHash, results[3].TxHash)
+
+	// failed transactions are reported for each of their blobs
+	submitter.pfbErr = errors.New("insufficient funds")
+	results, err = service.SubmitSplit(ctx, blobs[3:], nil)
+	require.NoError(t, err)
+	for _, result := range results {
+		require.Equal(t, submitter.pfbErr.Error(), result.Error)
+	}
+
+	_, err = service.SubmitSplit(ctx, blobs, &SubmitOptions{Fee: 10, GasLimit: 100})
+	require.ErrorIs(t, err, ErrSplitGasOptions)
+}
+
+func TestPFBSize(t *testing.T) {
+	ring := keyring.NewInMemory(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec)
+	_, _, err := ring.NewMnemonic("signer", keyring.English, types.GetConfig().GetFullBIP44Path(),
+		keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signer := apptypes.NewKeyringSigner(ring, "signer", "private")
+	signer.SetAccountNumber(10)
+	signer.SetSequence(100)
+
+	appBlobs, err := blobtest.GenerateV0Blobs([]int{1, 10, 100, 1000}, false)
+	require.NoError(t, err)
+	blobs, err := convertBlobs(appBlobs...)
+	require.NoError(t, err)
+
+	var size pfbSize
+	msg := &apptypes.MsgPayForBlobs{Signer: pfbSigner}
+	for _, blob := range blobs {
+		size.add(blob)
+		msg.Namespaces = append(msg.Namespaces, blob.Namespace())
+		msg.BlobSizes = append(msg.BlobSizes, uint32(len(blob.Data)))
+		msg.ShareCommitments = append(msg.ShareCommitments, blob.Commitment)
+		msg.ShareVersions = append(msg.ShareVersions, blob.ShareVersion)
+
+		tx, err := signer.BuildSignedTx(signer.NewTxBuilder(
+			apptypes.SetGasLimit(200000),
+			apptypes.SetFeeAmount(types.NewCoins(types.NewCoin(appconsts.BondDenom, types.NewInt(20000)))),
+		), msg)
+		require.NoError(t, err)
+		rawTx, err := signer.EncodeTx(tx)
+		require.NoError(t, err)
+
+		// the size is exact up to the numbers of the signer info and the fee
+		require.GreaterOrEqual(t, size.size(), len(rawTx))
+		require.Less(t, size.size()-len(rawTx), 32)
+	}
+}
+
+type submitterStub struct {
+	lock    sync.Mutex
+	counter int
+	txs     map[string]*types.TxResponse
+	// submitted are the blobs of the synchronously submitted transactions
+	submitted [][]*Blob
+	// pfbErr fails the synchronously submitted transactions
+	pfbErr error
+}
+
+func (s *submitterStub) SubmitPayForBlobWithAccount(
+	_ context.Context,
+	_ math.Int,
+	_ uint64,
+	blobs []*Blob,
+	_ string,
+) (*types.TxResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pfbErr != nil {
+		return nil, s.pfbErr
+	}
+	s.counter++
+	s.submitted = append(s.submitted, blobs)
+	return &types.TxResponse{
+		Height: int64(s.counter),
+		TxHash: fmt.Sprintf("%X", sha256.Sum256([]byte{byte(s.counter)})),
+	}, nil
+}
+
+func (s *submitterStub) SubmitPayForBlobAsync(
+	context.Context,
+	math.Int,
+	uint64,
+	[]*Blob,
+	string,
+) (*types.TxResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.counter++
+	return &types.TxResponse{TxHash: fmt.Sprintf("%X", sha256.Sum256([]byte{byte(s.counter)}))}, nil
+}
+
+func (s *submitterStub) TxStatus(_ context.Context, txHash string) (*types.TxResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.txs[txHash], nil
+}
+
+func (s *submitterStub) SquareParams(context.Context) (int, uint64, error) {
+	return appconsts.DefaultGovMaxSquareSize, appconsts.LatestVersion, nil
+}
+
+func (s *submitterStub) commit(txHash string, resp *types.TxResponse) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.txs[txHash] = resp
 }
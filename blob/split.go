@@ -0,0 +1,278 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/bits"
+	"sync"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+var (
+	ErrBlobTooLarge = errors.New("blob: too large to fit into a square")
+	// ErrSplitGasOptions is returned when the fee or the gas limit is set for a split submission.
+	// The number of transactions and the blobs they pay for are only known once the blobs are
+	// split, so the gas limit and the fee are estimated for every transaction separately rather
+	// than applied to each of them as given.
+	ErrSplitGasOptions = errors.New("blob: fee and gas limit are estimated per transaction of a split submission")
+)
+
+// SubmitResult reports the outcome of a single blob of a split submission.
+type SubmitResult struct {
+	Commitment Commitment `json:"commitment"`
+	// TxHash and Height identify the PayForBlob transaction the blob was included with. They are
+	// shared by all the blobs of the transaction.
+	TxHash string `json:"tx_hash,omitempty"`
+	Height uint64 `json:"height,omitempty"`
+	// Error describes why the blob wasn't included. It is empty for included blobs.
+	Error string `json:"error,omitempty"`
+}
+
+// SubmitSplit sends the blobs in as many PFB transactions as needed for each of them to fit into
+// the square, instead of failing when the blobs don't fit into a single one. The transactions are
+// submitted concurrently and are not atomic: some of them may be included while others fail.
+// A result is reported for every blob, in the order of the given blobs, so the failed ones can be
+// resubmitted. Blobs that can't fit into the square on their own fail with ErrBlobTooLarge
+// without being submitted.
+// Uses default wallet registered on the Node, unless another account is set in the options.
+// The gas limit and the fee are estimated for every transaction and can't be set in the options.
+func (s *Service) SubmitSplit(ctx context.Context, blobs []*Blob, options *SubmitOptions) ([]*SubmitResult, error) {
+	if len(blobs) == 0 {
+		return nil, errors.New("blob: no blobs provided")
+	}
+	if options == nil {
+		options = DefaultSubmitOptions()
+	}
+	if options.Fee >= 0 || options.GasLimit != 0 {
+		return nil, ErrSplitGasOptions
+	}
+
+	results := make([]*SubmitResult, len(blobs))
+	for i, blob := range blobs {
+		results[i] = &SubmitResult{Commitment: blob.Commitment}
+	}
+
+	maxSquareSize, appVersion, err := s.blobSubmitter.SquareParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batches, tooLarge, err := splitBlobs(blobs, maxSquareSize, appVersion)
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range tooLarge {
+		results[idx].Error = ErrBlobTooLarge.Error()
+	}
+	log.Debugw("submitting split blobs", "amount", len(blobs), "transactions", len(batches))
+
+	var wg sync.WaitGroup
+	for _, batch := range batches {
+		wg.Add(1)
+		go func(batch []int) {
+			defer wg.Done()
+
+			batchBlobs := make([]*Blob, len(batch))
+			for i, idx := range batch {
+				batchBlobs[i] = blobs[idx]
+			}
+			height, txHash, err := s.submit(ctx, batchBlobs, options)
+			for _, idx := range batch {
+				if err != nil {
+					results[idx].Error = err.Error()
+					continue
+				}
+				results[idx].TxHash = txHash
+				results[idx].Height = height
+			}
+		}(batch)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// submit sends the blobs in a single PFB transaction and reports its height and hash.
+func (s *Service) submit(ctx context.Context, blobs []*Blob, options *SubmitOptions) (uint64, string, error) {
+	resp, err := s.blobSubmitter.SubmitPayForBlobWithAccount(
+		ctx,
+		types.NewInt(options.Fee),
+		options.GasLimit,
+		blobs,
+		options.Account,
+	)
+	if err != nil {
+		return 0, "", err
+	}
+	return uint64(resp.Height), resp.TxHash, nil
+}
+
+// splitBlobs greedily groups the consecutive blobs into batches of blob indexes, such that every
+// batch fits into the square of the given size along with its PayForBlobs transaction. The indexes
+// of the blobs that don't fit into the square on their own are reported separately.
+func splitBlobs(blobs []*Blob, maxSquareSize int, appVersion uint64) (batches [][]int, tooLarge []int, err error) {
+	var (
+		batch   []int
+		builder *batchBuilder
+	)
+	for idx, blob := range blobs {
+		if builder == nil {
+			builder, err = newBatchBuilder(maxSquareSize, appVersion)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if builder.append(blob) {
+			batch = append(batch, idx)
+			continue
+		}
+		if len(batch) == 0 {
+			// the blob doesn't fit into the empty square
+			tooLarge = append(tooLarge, idx)
+			continue
+		}
+
+		batches = append(batches, batch)
+		batch = nil
+		builder, err = newBatchBuilder(maxSquareSize, appVersion)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !builder.append(blob) {
+			tooLarge = append(tooLarge, idx)
+			continue
+		}
+		batch = []int{idx}
+	}
+	if len(batch) != 0 {
+		batches = append(batches, batch)
+	}
+	return batches, tooLarge, nil
+}
+
+// batchBuilder lays out the blobs of a single PayForBlobs transaction in the square one by one.
+// Each blob is appended to the square along with the part of the transaction that pays for it, so
+// the transaction takes slightly more space in the square than it does as a whole.
+type batchBuilder struct {
+	builder *square.Builder
+	pfb     pfbSize
+}
+
+func newBatchBuilder(maxSquareSize int, appVersion uint64) (*batchBuilder, error) {
+	builder, err := square.NewBuilder(maxSquareSize, appVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &batchBuilder{builder: builder}, nil
+}
+
+// append adds the blob to the batch if it fits into the square, accounting for the worst case
+// padding of the blob.
+func (b *batchBuilder) append(blob *Blob) bool {
+	pfb := b.pfb
+	pfb.add(blob)
+
+	blobTx := tmproto.BlobTx{
+		// the transaction is not signed yet, so only its size is accounted for
+		Tx:    make([]byte, pfb.size()-b.pfb.size()),
+		Blobs: []*tmproto.Blob{&blob.Blob},
+	}
+	if !b.builder.AppendBlobTx(blobTx) {
+		return false
+	}
+	b.pfb = pfb
+	return true
+}
+
+const (
+	// secp256k1SignatureSize is the size of the signature of a transaction.
+	secp256k1SignatureSize = 64
+	// addressSize is the size of an account address.
+	addressSize = 20
+)
+
+var (
+	// pfbSigner is an address of the length of every account address, which is what the size of
+	// the PayForBlobs message depends on.
+	pfbSigner = func() string {
+		addr, err := bech32.ConvertAndEncode(app.Bech32PrefixAccAddr, make([]byte, addressSize))
+		if err != nil {
+			panic(err)
+		}
+		return addr
+	}()
+	// pfbAuthInfoSize is the size of the signer info and the fee of a transaction signed by
+	// a single secp256k1 key, with every number set to its maximum.
+	pfbAuthInfoSize = func() int {
+		pubKey, err := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: make([]byte, secp256k1.PubKeySize)})
+		if err != nil {
+			panic(err)
+		}
+		authInfo := sdktx.AuthInfo{
+			SignerInfos: []*sdktx.SignerInfo{{
+				PublicKey: pubKey,
+				ModeInfo: &sdktx.ModeInfo{Sum: &sdktx.ModeInfo_Single_{
+					Single: &sdktx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT},
+				}},
+				Sequence: math.MaxUint64,
+			}},
+			Fee: &sdktx.Fee{
+				Amount:   types.NewCoins(types.NewCoin(appconsts.BondDenom, types.NewInt(math.MaxInt64))),
+				GasLimit: math.MaxUint64,
+			},
+		}
+		return authInfo.Size()
+	}()
+)
+
+// pfbSize computes the size of a signed PayForBlobs transaction, as encoded by the signer, from
+// the blobs it pays for. The size is exact up to the numbers of the signer info and the fee, which
+// are accounted for with their maximum values.
+type pfbSize struct {
+	blobs int
+	// namespaces and commitments are the encoded sizes of the repeated fields of the message.
+	namespaces, commitments int
+	// blobSizes and shareVersions are the sizes of the packed repeated fields of the message,
+	// without the field headers.
+	blobSizes, shareVersions int
+}
+
+func (p *pfbSize) add(blob *Blob) {
+	p.blobs++
+	p.namespaces += fieldSize(len(blob.Namespace()))
+	p.commitments += fieldSize(len(blob.Commitment))
+	p.blobSizes += varintSize(uint64(len(blob.Data)))
+	p.shareVersions += varintSize(uint64(blob.ShareVersion))
+}
+
+func (p *pfbSize) size() int {
+	if p.blobs == 0 {
+		return 0
+	}
+	msg := fieldSize(len(pfbSigner)) + p.namespaces + fieldSize(p.blobSizes) + p.commitments +
+		fieldSize(p.shareVersions)
+	anyMsg := fieldSize(len(apptypes.URLMsgPayForBlobs)) + fieldSize(msg)
+	body := fieldSize(anyMsg)
+	return fieldSize(body) + fieldSize(pfbAuthInfoSize) + fieldSize(secp256k1SignatureSize)
+}
+
+// fieldSize returns the encoded size of a length-delimited protobuf field with the given length
+// and a field number below 16.
+func fieldSize(length int) int {
+	return 1 + varintSize(uint64(length)) + length
+}
+
+func varintSize(x uint64) int {
+	return (bits.Len64(x|1) + 6) / 7
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/go-header/store"
+
+	cmdnode "github.com/celestiaorg/celestia-node/cmd"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/nodebuilder"
+	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/share/eds"
+)
+
+const (
+	edsFromFlag = "from"
+	edsToFlag   = "to"
+
+	carExt = ".car"
+)
+
+func init() {
+	edsCmd.AddCommand(edsVerifyCmd, edsExportCmd, edsImportCmd)
+
+	for _, cmd := range []*cobra.Command{edsVerifyCmd, edsExportCmd, edsImportCmd} {
+		cmd.Flags().Uint64(edsFromFlag, 1, "First height to process. 1 by default.")
+		cmd.Flags().Uint64(edsToFlag, 0, "Last height to process. Head of the header store by default.")
+	}
+}
+
+var edsCmd = &cobra.Command{
+	Use:   "eds [subcommand]",
+	Short: "Collection of utilities over the eds.Store of a stopped node",
+}
+
+var edsVerifyCmd = &cobra.Command{
+	Use: "verify [node-type] [network]",
+	Short: `Verifies the EDSes of the given heights stored by the stopped node against the data roots of
+their headers, reporting the missing and the corrupted ones.`,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withEDSStore(cmd, args, func(es *edsStores, from, to uint64) error {
+			var missing, corrupted []uint64
+			for height := from; height <= to; height++ {
+				h, err := es.headers.GetByHeight(cmd.Context(), height)
+				if err != nil {
+					return fmt.Errorf("getting header %d: %w", height, err)
+				}
+
+				err = es.verify(cmd.Context(), h)
+				switch {
+				case errors.Is(err, eds.ErrNotFound):
+					fmt.Printf("height %d: missing EDS %s\n", height, h.DataHash)
+					missing = append(missing, height)
+				case err != nil:
+					fmt.Printf("height %d: corrupted EDS %s: %s\n", height, h.DataHash, err)
+					corrupted = append(corrupted, height)
+				}
+			}
+
+			fmt.Printf("verified heights from %d to %d: %d missing, %d corrupted\n",
+				from, to, len(missing), len(corrupted))
+			if len(corrupted) > 0 {
+				return fmt.Errorf("found corrupted EDSes at heights %v", corrupted)
+			}
+			return nil
+		})
+	},
+}
+
+var edsExportCmd = &cobra.Command{
+	Use: "export [node-type] [network] [directory]",
+	Short: `Exports the EDSes of the given heights stored by the stopped node into the directory as CAR files
+named after their heights.`,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := args[2]
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+
+		return withEDSStore(cmd, args[:2], func(es *edsStores, from, to uint64) error {
+			var exported int
+			for height := from; height <= to; height++ {
+				h, err := es.headers.GetByHeight(cmd.Context(), height)
+				if err != nil {
+					return fmt.Errorf("getting header %d: %w", height, err)
+				}
+
+				path := filepath.Join(dir, strconv.FormatUint(height, 10)+carExt)
+				err = es.export(cmd.Context(), h, path)
+				if errors.Is(err, eds.ErrNotFound) {
+					fmt.Printf("height %d: skipping missing EDS %s\n", height, h.DataHash)
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("exporting height %d: %w", height, err)
+				}
+				exported++
+			}
+
+			fmt.Printf("exported %d EDSes of heights from %d to %d into %s\n", exported, from, to, dir)
+			return nil
+		})
+	},
+}
+
+var edsImportCmd = &cobra.Command{
+	Use: "import [node-type] [network] [directory]",
+	Short: `Imports the CAR files named after heights from the directory into the eds.Store of the stopped node,
+verifying them against the data roots of their headers.`,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := os.ReadDir(args[2])
+		if err != nil {
+			return err
+		}
+
+		return withEDSStore(cmd, args[:2], func(es *edsStores, from, to uint64) error {
+			var imported int
+			for _, entry := range entries {
+				name := entry.Name()
+				if entry.IsDir() || !strings.HasSuffix(name, carExt) {
+					continue
+				}
+				height, err := strconv.ParseUint(strings.TrimSuffix(name, carExt), 10, 64)
+				if err != nil || height < from || height > to {
+					continue
+				}
+
+				h, err := es.headers.GetByHeight(cmd.Context(), height)
+				if err != nil {
+					return fmt.Errorf("getting header %d: %w", height, err)
+				}
+
+				ok, err := es.importCAR(cmd.Context(), h, filepath.Join(args[2], name))
+				if err != nil {
+					return fmt.Errorf("importing height %d: %w", height, err)
+				}
+				if !ok {
+					fmt.Printf("height %d: EDS %s is already stored\n", height, h.DataHash)
+					continue
+				}
+				imported++
+			}
+
+			fmt.Printf("imported %d EDSes from %s\n", imported, args[2])
+			return nil
+		})
+	},
+}
+
+// edsStores gives access to the eds.Store of a node along with its header store.
+type edsStores struct {
+	eds     *eds.Store
+	headers *store.Store[*header.ExtendedHeader]
+}
+
+// verify recomputes the root of the stored EDS of the header and compares it to the data root.
+func (es *edsStores) verify(ctx context.Context, h *header.ExtendedHeader) error {
+	r, err := es.eds.GetCAR(ctx, h.DataHash.Bytes())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// ReadEDS recomputes the EDS and ensures its root matches the data root
+	_, err = eds.ReadEDS(ctx, r, h.DataHash.Bytes())
+	return err
+}
+
+// export writes the stored CAR file of the header to the given path.
+func (es *edsStores) export(ctx context.Context, h *header.ExtendedHeader, path string) (err error) {
+	r, err := es.eds.GetCAR(ctx, h.DataHash.Bytes())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// importCAR puts the EDS of the header from the CAR file at the given path into the store, unless
+// it is stored already. The EDS is verified against the data root of the header.
+func (es *edsStores) importCAR(ctx context.Context, h *header.ExtendedHeader, path string) (bool, error) {
+	has, err := es.eds.Has(ctx, h.DataHash.Bytes())
+	if err != nil || has {
+		return false, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	square, err := eds.ReadEDS(ctx, f, h.DataHash.Bytes())
+	if err != nil {
+		return false, err
+	}
+	return true, es.eds.Put(ctx, h.DataHash.Bytes(), square)
+}
+
+// withEDSStore opens the stores of the stopped node of the given type and network and runs the
+// given func over the range of heights requested by the flags.
+func withEDSStore(
+	cmd *cobra.Command,
+	args []string,
+	fn func(es *edsStores, from, to uint64) error,
+) (err error) {
+	tp := node.ParseType(args[0])
+	if !tp.IsValid() {
+		return fmt.Errorf("invalid node-type")
+	}
+	if tp == node.Light {
+		return fmt.Errorf("light nodes don't store EDSes")
+	}
+
+	path, err := cmdnode.DefaultNodeStorePath(tp.String(), args[1])
+	if err != nil {
+		return err
+	}
+
+	s, err := nodebuilder.OpenStore(path, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, s.Close())
+	}()
+
+	cfg, err := s.Config()
+	if err != nil {
+		return err
+	}
+	ds, err := s.Datastore()
+	if err != nil {
+		return err
+	}
+
+	hstore, err := store.NewStore[*header.ExtendedHeader](ds)
+	if err != nil {
+		return err
+	}
+	// loads the head of the store, so that headers can be requested by height
+	head, err := hstore.Head(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("getting head of the header store: %w", err)
+	}
+
+	from, _ := cmd.Flags().GetUint64(edsFromFlag)
+	to, _ := cmd.Flags().GetUint64(edsToFlag)
+	if to == 0 || to > head.Height() {
+		to = head.Height()
+	}
+	if from == 0 || from > to {
+		return fmt.Errorf("invalid heights range from %d to %d", from, to)
+	}
+
+	// open the store as configured for the node, so that its storage layout and tiers are found
+	edsStore, err := eds.NewStore(cfg.Share.EDSStoreParams, path, ds)
+	if err != nil {
+		return err
+	}
+	if err = edsStore.Start(cmd.Context()); err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, edsStore.Stop(cmd.Context()))
+	}()
+
+	return fn(&edsStores{eds: edsStore, headers: hstore}, from, to)
+}
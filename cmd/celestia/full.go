@@ -38,6 +38,7 @@ func init() {
 		cmdnode.ResetStore(flags...),
 		cmdnode.RemoveConfigCmd(flags...),
 		cmdnode.UpdateConfigCmd(flags...),
+		cmdnode.SnapshotCmd(flags...),
 	)
 }
 
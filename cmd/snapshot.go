@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder"
+)
+
+const (
+	snapshotFromFlag = "from"
+	snapshotToFlag   = "to"
+)
+
+// SnapshotCmd constructs a CLI command to create and restore snapshots of the Celestia Node
+// Store.
+func SnapshotCmd(fsets ...*flag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot [subcommand]",
+		Short: "Creates and restores snapshots of the node's headers and EDSes.",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(snapshotCreateCmd(fsets...), snapshotRestoreCmd(fsets...))
+	return cmd
+}
+
+func snapshotCreateCmd(fsets ...*flag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file]",
+		Short: "Writes the headers of the given range and their EDSes from the node's store into the file.",
+		Long: "Writes the headers of the given range and their EDSes from the node's store into the file, " +
+			"which can be restored by other nodes. NOTE: the node has to be stopped.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			ctx := cmd.Context()
+
+			from, err := cmd.Flags().GetUint64(snapshotFromFlag)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetUint64(snapshotToFlag)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, f.Close())
+			}()
+
+			return nodebuilder.CreateSnapshot(ctx, StorePath(ctx), NodeType(ctx), f, from, to)
+		},
+	}
+
+	cmd.Flags().Uint64(snapshotFromFlag, 1, "First height of the snapshot.")
+	cmd.Flags().Uint64(snapshotToFlag, 0, "Last height of the snapshot. The head of the node by default.")
+	for _, set := range fsets {
+		cmd.Flags().AddFlagSet(set)
+	}
+	return cmd
+}
+
+func snapshotRestoreCmd(fsets ...*flag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [file]",
+		Short: "Restores the headers and EDSes of the snapshot file into the node's store.",
+		Long: "Restores the headers and EDSes of the snapshot file into the initialized node's store. " +
+			"The snapshot has to continue the headers already stored by the node, if any. Otherwise, its " +
+			"first header must be the trusted one, set by the trusted hash flag or the node's config. " +
+			"NOTE: the node has to be stopped.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			// the trusted hash given by the flag, if any, takes precedence over the stored config
+			trustedHash, err := hex.DecodeString(NodeConfig(ctx).Header.TrustedHash)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			return nodebuilder.RestoreSnapshot(ctx, StorePath(ctx), NodeType(ctx), f, trustedHash)
+		},
+	}
+
+	for _, set := range fsets {
+		cmd.Flags().AddFlagSet(set)
+	}
+	return cmd
+}
@@ -11,6 +11,8 @@ type checkpoint struct {
 	Failed map[uint64]int `json:"failed,omitempty"`
 	// Workers will resume on restart from previous state
 	Workers []workerCheckpoint `json:"workers,omitempty"`
+	// Ranges are on-demand sampling ranges in progress, that will resume from their Next height
+	Ranges []RangeSampling `json:"ranges,omitempty"`
 }
 
 // workerCheckpoint will be used to resume worker on restart
@@ -38,6 +40,7 @@ func newCheckpoint(stats SamplingStats) checkpoint {
 		NetworkHead: stats.NetworkHead,
 		Failed:      stats.Failed,
 		Workers:     workers,
+		Ranges:      stats.Ranges,
 	}
 }
 
@@ -48,6 +51,10 @@ func (c checkpoint) String() string {
 		str += fmt.Sprintf(", Workers: %v", len(c.Workers))
 	}
 
+	if len(c.Ranges) > 0 {
+		str += fmt.Sprintf(", Ranges: %v", len(c.Ranges))
+	}
+
 	if len(c.Failed) > 0 {
 		str += fmt.Sprintf("\nFailed: %v", c.Failed)
 	}
@@ -16,11 +16,13 @@ type samplingCoordinator struct {
 	concurrencyLimit int
 	samplingTimeout  time.Duration
 
-	getter      libhead.Getter[*header.ExtendedHeader]
-	sampleFn    sampleFn
-	broadcastFn shrexsub.BroadcastFn
+	getter       libhead.Getter[*header.ExtendedHeader]
+	sampleFn     sampleFn
+	broadcastFn  shrexsub.BroadcastFn
+	storeRangeFn storeRangeFn
 
-	state coordinatorState
+	state  coordinatorState
+	events *eventBroadcaster
 
 	// resultCh fans-in sampling results from worker to coordinator
 	resultCh chan result
@@ -38,7 +40,9 @@ type samplingCoordinator struct {
 type result struct {
 	job
 	failed map[uint64]int
-	err    error
+	// errs keeps the sampling error of every failed height
+	errs map[uint64]error
+	err  error
 }
 
 func newSamplingCoordinator(
@@ -46,6 +50,7 @@ func newSamplingCoordinator(
 	getter libhead.Getter[*header.ExtendedHeader],
 	sample sampleFn,
 	broadcast shrexsub.BroadcastFn,
+	storeRange storeRangeFn,
 ) *samplingCoordinator {
 	return &samplingCoordinator{
 		concurrencyLimit: params.ConcurrencyLimit,
@@ -53,7 +58,9 @@ func newSamplingCoordinator(
 		getter:           getter,
 		sampleFn:         sample,
 		broadcastFn:      broadcast,
+		storeRangeFn:     storeRange,
 		state:            newCoordinatorState(params),
+		events:           newEventBroadcaster(),
 		resultCh:         make(chan result),
 		updHeadCh:        make(chan *header.ExtendedHeader),
 		waitCh:           make(chan *sync.WaitGroup),
@@ -90,6 +97,9 @@ func (sc *samplingCoordinator) run(ctx context.Context, cp checkpoint) {
 			}
 		case res := <-sc.resultCh:
 			sc.state.handleResult(res)
+			if res.jobType == rangeJob {
+				sc.storeRange(ctx, res.rangeID)
+			}
 		case wg := <-sc.waitCh:
 			wg.Wait()
 		case <-ctx.Done():
@@ -102,7 +112,7 @@ func (sc *samplingCoordinator) run(ctx context.Context, cp checkpoint) {
 
 // runWorker runs job in separate worker go-routine
 func (sc *samplingCoordinator) runWorker(ctx context.Context, j job) {
-	w := newWorker(j, sc.getter, sc.sampleFn, sc.broadcastFn, sc.metrics)
+	w := newWorker(j, sc.getter, sc.sampleFn, sc.broadcastFn, sc.events.publish, sc.metrics)
 	sc.state.putInProgress(j.id, w.getState)
 
 	// launch worker go-routine
@@ -136,6 +146,82 @@ func (sc *samplingCoordinator) stats(ctx context.Context) (SamplingStats, error)
 	return sc.state.unsafeStats(), nil
 }
 
+// sampleRange pauses the coordinator to schedule the on-demand sampling of the given range
+func (sc *samplingCoordinator) sampleRange(ctx context.Context, id int, from, to uint64) (RangeSampling, error) {
+	resume, err := sc.pause(ctx)
+	if err != nil {
+		return RangeSampling{}, err
+	}
+	defer resume()
+
+	return sc.state.addRange(id, from, to)
+}
+
+// failedHeights pauses the coordinator to list the heights that failed sampling
+func (sc *samplingCoordinator) failedHeights(ctx context.Context) ([]FailedHeight, error) {
+	resume, err := sc.pause(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resume()
+
+	return sc.state.failedHeights(), nil
+}
+
+// retryFailed pauses the coordinator to schedule an immediate retry of the failed height
+func (sc *samplingCoordinator) retryFailed(ctx context.Context, height uint64) error {
+	resume, err := sc.pause(ctx)
+	if err != nil {
+		return err
+	}
+	defer resume()
+
+	return sc.state.retryFailed(height)
+}
+
+// clearFailed pauses the coordinator to forget the failed height
+func (sc *samplingCoordinator) clearFailed(ctx context.Context, height uint64) error {
+	resume, err := sc.pause(ctx)
+	if err != nil {
+		return err
+	}
+	defer resume()
+
+	return sc.state.clearFailed(height)
+}
+
+// pause blocks the coordinator until the returned resume func is called, allowing to access its
+// state in a concurrently safe manner
+func (sc *samplingCoordinator) pause(ctx context.Context) (resume func(), err error) {
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	select {
+	case sc.waitCh <- wg:
+		return wg.Done, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// storeRange persists the outcome of the on-demand range once all of its headers are sampled
+func (sc *samplingCoordinator) storeRange(ctx context.Context, id int) {
+	r, done := sc.state.finishRange(id)
+	if !done {
+		return
+	}
+
+	log.Infow("finished sampling range",
+		"id", r.ID,
+		"from", r.From,
+		"to", r.To,
+		"errors", len(r.Failed),
+		"finished (s)", r.Finished.Sub(r.Started))
+	if err := sc.storeRangeFn(ctx, r); err != nil {
+		log.Errorw("storing sampling range outcome", "id", r.ID, "err", err)
+	}
+}
+
 func (sc *samplingCoordinator) getCheckpoint(ctx context.Context) (checkpoint, error) {
 	stats, err := sc.stats(ctx)
 	if err != nil {
@@ -23,7 +23,7 @@ func TestCoordinator(t *testing.T) {
 
 		ctx, cancel := context.WithTimeout(context.Background(), testParams.timeoutDelay)
 		sampler := newMockSampler(testParams.sampleFrom, testParams.networkHead)
-		coordinator := newSamplingCoordinator(testParams.dasParams, getterStub{}, onceMiddleWare(sampler.sample), nil)
+		coordinator := newSamplingCoordinator(testParams.dasParams, getterStub{}, onceMiddleWare(sampler.sample), nil, nil)
 
 		go coordinator.run(ctx, sampler.checkpoint)
 
@@ -49,7 +49,7 @@ func TestCoordinator(t *testing.T) {
 		sampler := newMockSampler(testParams.sampleFrom, testParams.networkHead)
 
 		newhead := testParams.networkHead + 200
-		coordinator := newSamplingCoordinator(testParams.dasParams, getterStub{}, sampler.sample, newBroadcastMock(1))
+		coordinator := newSamplingCoordinator(testParams.dasParams, getterStub{}, sampler.sample, newBroadcastMock(1), nil)
 		go coordinator.run(ctx, sampler.checkpoint)
 
 		// discover new height
@@ -100,6 +100,7 @@ func TestCoordinator(t *testing.T) {
 				order.middleWare(sampler.sample),
 			),
 			newBroadcastMock(1),
+			nil,
 		)
 		go coordinator.run(ctx, sampler.checkpoint)
 
@@ -143,7 +144,7 @@ func TestCoordinator(t *testing.T) {
 
 		lk := newLock(testParams.sampleFrom, testParams.networkHead) // lock all workers before start
 		coordinator := newSamplingCoordinator(testParams.dasParams, getterStub{},
-			lk.middleWare(sampler.sample), newBroadcastMock(1))
+			lk.middleWare(sampler.sample), newBroadcastMock(1), nil)
 		go coordinator.run(ctx, sampler.checkpoint)
 
 		// discover new height and lock it
@@ -194,6 +195,7 @@ func TestCoordinator(t *testing.T) {
 			getterStub{},
 			onceMiddleWare(sampler.sample),
 			newBroadcastMock(1),
+			nil,
 		)
 		go coordinator.run(ctx, sampler.checkpoint)
 
@@ -236,6 +238,7 @@ func TestCoordinator(t *testing.T) {
 			getterStub{},
 			onceMiddleWare(sampler.sample),
 			newBroadcastMock(1),
+			nil,
 		)
 		go coordinator.run(ctx, sampler.checkpoint)
 
@@ -285,6 +288,7 @@ func TestCoordinator(t *testing.T) {
 			getterStub{},
 			sampleFn,
 			newBroadcastMock(1),
+			nil,
 		)
 
 		go coordinator.run(ctx, ch)
@@ -299,6 +303,219 @@ func TestCoordinator(t *testing.T) {
 		st := coordinator.state.unsafeStats()
 		require.Equal(t, ch, newCheckpoint(st))
 	})
+
+	t.Run("sample range on demand", func(t *testing.T) {
+		testParams := defaultTestParams()
+		testParams.dasParams.SamplingRange = 4
+		ctx, cancel := context.WithTimeout(context.Background(), testParams.timeoutDelay)
+		defer cancel()
+
+		var lk sync.Mutex
+		sampled := make(map[uint64]int)
+		sampleFn := func(ctx context.Context, h *header.ExtendedHeader) error {
+			lk.Lock()
+			defer lk.Unlock()
+			sampled[h.Height()]++
+			if h.Height() == 15 {
+				return errors.New("born to fail, sad life")
+			}
+			return nil
+		}
+
+		storedCh := make(chan RangeSampling, 1)
+		storeRange := func(ctx context.Context, r RangeSampling) error {
+			storedCh <- r
+			return nil
+		}
+
+		coordinator := newSamplingCoordinator(
+			testParams.dasParams,
+			getterStub{},
+			sampleFn,
+			newBroadcastMock(1),
+			storeRange,
+		)
+		// everything up to the network head is sampled already
+		go coordinator.run(ctx, checkpoint{
+			SampleFrom:  testParams.networkHead + 1,
+			NetworkHead: testParams.networkHead,
+		})
+
+		_, err := coordinator.sampleRange(ctx, 1, 30, 10)
+		require.ErrorIs(t, err, ErrInvalidRange)
+		_, err = coordinator.sampleRange(ctx, 1, 10, testParams.networkHead+1)
+		require.ErrorIs(t, err, ErrInvalidRange)
+
+		r, err := coordinator.sampleRange(ctx, 2, 10, 30)
+		require.NoError(t, err)
+		assert.Equal(t, 2, r.ID)
+		assert.False(t, r.Done)
+
+		var stored RangeSampling
+		select {
+		case stored = <-storedCh:
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		}
+		assert.Equal(t, 2, stored.ID)
+		assert.True(t, stored.Done)
+		assert.Equal(t, uint64(21), stored.Sampled)
+		assert.Equal(t, uint64(31), stored.Next)
+		assert.Equal(t, []uint64{15}, stored.Failed)
+
+		lk.Lock()
+		for h := uint64(10); h <= 30; h++ {
+			assert.Equal(t, 1, sampled[h], "height %d", h)
+		}
+		assert.Len(t, sampled, 21)
+		lk.Unlock()
+
+		// range failures are not retried and the finished range is not reported anymore
+		stats, err := coordinator.stats(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, stats.Failed)
+		assert.Empty(t, stats.Ranges)
+		assert.Equal(t, testParams.networkHead, stats.SampledChainHead)
+	})
+
+	t.Run("range should resume on restart", func(t *testing.T) {
+		testParams := defaultTestParams()
+		ctx, cancel := context.WithTimeout(context.Background(), testParams.timeoutDelay)
+		defer cancel()
+
+		sampleFn := func(ctx context.Context, h *header.ExtendedHeader) error {
+			if h.Height() < 20 {
+				return fmt.Errorf("header %d was sampled before restart", h.Height())
+			}
+			return nil
+		}
+
+		storedCh := make(chan RangeSampling, 1)
+		storeRange := func(ctx context.Context, r RangeSampling) error {
+			storedCh <- r
+			return nil
+		}
+
+		coordinator := newSamplingCoordinator(
+			testParams.dasParams,
+			getterStub{},
+			sampleFn,
+			newBroadcastMock(1),
+			storeRange,
+		)
+		go coordinator.run(ctx, checkpoint{
+			SampleFrom:  testParams.networkHead + 1,
+			NetworkHead: testParams.networkHead,
+			Ranges: []RangeSampling{{
+				ID:      1,
+				From:    10,
+				To:      30,
+				Next:    20,
+				Sampled: 15,
+				Failed:  []uint64{12, 25},
+			}},
+		})
+
+		select {
+		case stored := <-storedCh:
+			assert.Equal(t, uint64(21), stored.Sampled)
+			assert.Equal(t, []uint64{12}, stored.Failed)
+			assert.True(t, stored.Done)
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		}
+	})
+
+	t.Run("failed heights can be retried and cleared", func(t *testing.T) {
+		testParams := defaultTestParams()
+		ctx, cancel := context.WithTimeout(context.Background(), testParams.timeoutDelay)
+		defer cancel()
+
+		var lk sync.Mutex
+		attempts := make(map[uint64]int)
+		sampleFn := func(ctx context.Context, h *header.ExtendedHeader) error {
+			lk.Lock()
+			defer lk.Unlock()
+			attempts[h.Height()]++
+			// fail only on the first attempt
+			if (h.Height() == 5 || h.Height() == 7) && attempts[h.Height()] == 1 {
+				return errors.New("born to fail, sad life")
+			}
+			return nil
+		}
+
+		coordinator := newSamplingCoordinator(
+			testParams.dasParams,
+			getterStub{},
+			sampleFn,
+			newBroadcastMock(1),
+			nil,
+		)
+		events := coordinator.events.subscribe(ctx)
+		go coordinator.run(ctx, checkpoint{
+			SampleFrom:  1,
+			NetworkHead: 20,
+		})
+
+		nextEvent := func() *SamplingEvent {
+			select {
+			case e := <-events:
+				return e
+			case <-ctx.Done():
+				t.Fatal(ctx.Err())
+				return nil
+			}
+		}
+
+		var failed []uint64
+		for i := 0; i < 20; i++ {
+			if e := nextEvent(); e.Failed() {
+				assert.Equal(t, 1, e.Attempt)
+				assert.Equal(t, catchupJob, e.JobType)
+				failed = append(failed, e.Height)
+			}
+		}
+		assert.ElementsMatch(t, []uint64{5, 7}, failed)
+
+		// wait for the catchup workers to report failed heights to the coordinator
+		var heights []FailedHeight
+		require.Eventually(t, func() bool {
+			var err error
+			heights, err = coordinator.failedHeights(ctx)
+			return err == nil && len(heights) == 2
+		}, testParams.timeoutDelay, 10*time.Millisecond)
+		for i, h := range []uint64{5, 7} {
+			assert.Equal(t, h, heights[i].Height)
+			assert.Equal(t, 1, heights[i].Attempts)
+			assert.Equal(t, "born to fail, sad life", heights[i].LastError)
+			assert.True(t, heights[i].NextRetry.After(time.Now()))
+			assert.False(t, heights[i].InRetry)
+		}
+
+		require.ErrorIs(t, coordinator.retryFailed(ctx, 6), ErrHeightNotFailed)
+		require.ErrorIs(t, coordinator.clearFailed(ctx, 6), ErrHeightNotFailed)
+
+		require.NoError(t, coordinator.clearFailed(ctx, 7))
+		require.NoError(t, coordinator.retryFailed(ctx, 5))
+
+		e := nextEvent()
+		assert.Equal(t, uint64(5), e.Height)
+		assert.Equal(t, retryJob, e.JobType)
+		assert.Equal(t, 2, e.Attempt)
+		assert.False(t, e.Failed())
+
+		// catchup is done once the retried height succeeds
+		require.NoError(t, coordinator.state.waitCatchUp(ctx))
+
+		heights, err := coordinator.failedHeights(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, heights)
+
+		stats, err := coordinator.stats(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, stats.Failed)
+		assert.Equal(t, uint64(20), stats.SampledChainHead)
+	})
 }
 
 func BenchmarkCoordinator(b *testing.B) {
@@ -315,6 +532,7 @@ func BenchmarkCoordinator(b *testing.B) {
 			newBenchGetter(),
 			func(ctx context.Context, h *header.ExtendedHeader) error { return nil },
 			newBroadcastMock(1),
+			nil,
 		)
 		go coordinator.run(ctx, checkpoint{
 			SampleFrom:  1,
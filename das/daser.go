@@ -20,6 +20,16 @@ import (
 
 var log = logging.Logger("das")
 
+// ErrSamplingResultsNotSupported is returned by SamplingResult when the share.Availability of the
+// DASer doesn't record the results of its sampling rounds.
+var ErrSamplingResultsNotSupported = errors.New("das: sampling results are not recorded by the availability")
+
+// samplingResults is implemented by the share.Availability implementations that record the results
+// of their sampling rounds.
+type samplingResults interface {
+	SamplingResult(ctx context.Context, height uint64) (*share.SamplingResult, error)
+}
+
 // DASer continuously validates availability of data committed to headers.
 type DASer struct {
 	params Parameters
@@ -40,6 +50,7 @@ type DASer struct {
 
 type listenFn func(context.Context, *header.ExtendedHeader)
 type sampleFn func(context.Context, *header.ExtendedHeader) error
+type storeRangeFn func(context.Context, RangeSampling) error
 
 // NewDASer creates a new DASer.
 func NewDASer(
@@ -71,7 +82,7 @@ func NewDASer(
 		return nil, err
 	}
 
-	d.sampler = newSamplingCoordinator(d.params, getter, d.sample, shrexBroadcast)
+	d.sampler = newSamplingCoordinator(d.params, getter, d.sample, shrexBroadcast, d.store.storeRange)
 	return d, nil
 }
 
@@ -167,6 +178,79 @@ func (d *DASer) SamplingStats(ctx context.Context) (SamplingStats, error) {
 	return d.sampler.stats(ctx)
 }
 
+// SampleRange schedules the sampling of the headers from the given range, including the ones that
+// were sampled before, e.g. to re-verify historical data. The range is sampled by the same workers
+// as the catchup, but with a higher priority. Its progress is reported by SamplingStats and its
+// outcome is kept after it finishes. The returned RangeSampling carries the ID of the range.
+func (d *DASer) SampleRange(ctx context.Context, from, to uint64) (*RangeSampling, error) {
+	id, err := d.store.newRangeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := d.sampler.sampleRange(ctx, id, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RangeSampling returns the progress or, if it is finished, the outcome of the on-demand sampling
+// range with the given ID.
+func (d *DASer) RangeSampling(ctx context.Context, id int) (*RangeSampling, error) {
+	stats, err := d.sampler.stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range stats.Ranges {
+		if r.ID == id {
+			return &r, nil
+		}
+	}
+
+	r, err := d.store.loadRange(ctx, id)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, ErrRangeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// FailedHeights returns the heights of the headers that failed sampling together with their
+// amount of attempts, last errors and the time of the next retry.
+func (d *DASer) FailedHeights(ctx context.Context) ([]FailedHeight, error) {
+	return d.sampler.failedHeights(ctx)
+}
+
+// RetryFailed retries sampling of the failed header of the given height immediately, regardless of
+// its retry backoff.
+func (d *DASer) RetryFailed(ctx context.Context, height uint64) error {
+	return d.sampler.retryFailed(ctx, height)
+}
+
+// ClearFailed stops retrying sampling of the failed header of the given height.
+func (d *DASer) ClearFailed(ctx context.Context, height uint64) error {
+	return d.sampler.clearFailed(ctx, height)
+}
+
+// Subscribe streams the outcome of sampling of every header as soon as it is known. Events are
+// dropped if the subscriber does not keep up. The channel is closed once the context is canceled.
+func (d *DASer) Subscribe(ctx context.Context) (<-chan *SamplingEvent, error) {
+	return d.sampler.events.subscribe(ctx), nil
+}
+
+// SamplingResult returns the coordinates, outcomes, sources and latencies of the samples of the
+// last sampling round over the header of the given height.
+func (d *DASer) SamplingResult(ctx context.Context, height uint64) (*share.SamplingResult, error) {
+	results, ok := d.da.(samplingResults)
+	if !ok {
+		return nil, ErrSamplingResultsNotSupported
+	}
+	return results.SamplingResult(ctx, height)
+}
+
 // WaitCatchUp waits for DASer to indicate catchup is done
 func (d *DASer) WaitCatchUp(ctx context.Context) error {
 	return d.sampler.state.waitCatchUp(ctx)
@@ -0,0 +1,75 @@
+package das
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// eventsBufferSize is the amount of sampling events buffered for a subscriber.
+const eventsBufferSize = 64
+
+type publishFn func(SamplingEvent)
+
+// SamplingEvent is the outcome of sampling a single header.
+type SamplingEvent struct {
+	Height  uint64  `json:"height"`
+	JobType jobType `json:"job_type"`
+	// RangeID is the ID of the on-demand sampling range of range jobs
+	RangeID int `json:"range_id,omitempty"`
+	// Attempt is the number of the sampling attempt of the header, it is greater than 1 for retries
+	Attempt int `json:"attempt"`
+	// ErrMsg is the error of the failed sampling, it is empty if sampling succeeded
+	ErrMsg string    `json:"error,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// Failed reports whether sampling of the header failed.
+func (e SamplingEvent) Failed() bool {
+	return e.ErrMsg != ""
+}
+
+// eventBroadcaster fans out sampling events to all subscribers. It never blocks the workers:
+// events are dropped for the subscribers that don't keep up.
+type eventBroadcaster struct {
+	lk   sync.Mutex
+	subs map[chan *SamplingEvent]struct{}
+}
+
+func newEventBroadcaster() *eventBroadcaster {
+	return &eventBroadcaster{subs: make(map[chan *SamplingEvent]struct{})}
+}
+
+// subscribe returns a channel of sampling events that is closed once the context is canceled.
+func (b *eventBroadcaster) subscribe(ctx context.Context) <-chan *SamplingEvent {
+	ch := make(chan *SamplingEvent, eventsBufferSize)
+
+	b.lk.Lock()
+	b.subs[ch] = struct{}{}
+	b.lk.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.lk.Lock()
+		defer b.lk.Unlock()
+		delete(b.subs, ch)
+		close(ch)
+	}()
+	return ch
+}
+
+// publish sends the event to all subscribers.
+func (b *eventBroadcaster) publish(event SamplingEvent) {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	for ch := range b.subs {
+		e := event
+		select {
+		case ch <- &e:
+		default:
+			log.Warnw("dropping sampling event for slow subscriber", "height", event.Height)
+		}
+	}
+}
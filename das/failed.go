@@ -0,0 +1,29 @@
+package das
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrHeightNotFailed is returned by RetryFailed and ClearFailed when the header of the given height
+	// did not fail sampling.
+	ErrHeightNotFailed = errors.New("das: height did not fail sampling")
+	// ErrHeightInRetry is returned by ClearFailed when the header of the given height is being
+	// retried at the moment.
+	ErrHeightInRetry = errors.New("das: height is being retried")
+)
+
+// FailedHeight describes a header that failed sampling and awaits a retry.
+type FailedHeight struct {
+	Height uint64 `json:"height"`
+	// Attempts is the amount of failed sampling attempts of the header.
+	Attempts int `json:"attempts"`
+	// LastError is the error of the last failed attempt. It is empty for the heights that failed
+	// before the last restart of the DASer.
+	LastError string `json:"last_error,omitempty"`
+	// NextRetry is the earliest time of the next retry attempt.
+	NextRetry time.Time `json:"next_retry"`
+	// InRetry indicates whether the header is being retried at the moment.
+	InRetry bool `json:"in_retry"`
+}
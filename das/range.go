@@ -0,0 +1,41 @@
+package das
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidRange is returned by SampleRange when the range is empty, starts at height 0 or
+	// ends above the known network head.
+	ErrInvalidRange = errors.New("das: invalid sampling range")
+	// ErrRangeNotFound is returned by RangeSampling when no range with the given ID was scheduled.
+	ErrRangeNotFound = errors.New("das: sampling range not found")
+)
+
+// RangeSampling describes the on-demand sampling of a range of headers scheduled with SampleRange.
+type RangeSampling struct {
+	ID   int    `json:"id"`
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+	// Next is the lowest height of the range that is not sampled yet.
+	Next uint64 `json:"next"`
+	// Sampled is the amount of headers of the range that were sampled, successfully or not.
+	Sampled uint64 `json:"sampled"`
+	// Failed contains the heights of the headers of the range that failed sampling.
+	Failed  []uint64  `json:"failed,omitempty"`
+	Started time.Time `json:"started"`
+	// Done indicates whether all the headers of the range were sampled. Finished is set only then.
+	Done     bool      `json:"done"`
+	Finished time.Time `json:"finished"`
+}
+
+// rangeState tracks the progress of an on-demand sampling range.
+type rangeState struct {
+	RangeSampling
+
+	// all heights of the range before next were sent to workers
+	next uint64
+	// jobs is the amount of jobs of the range that are in progress
+	jobs int
+}
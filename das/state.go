@@ -1,7 +1,10 @@
 package das
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -26,6 +29,9 @@ type coordinatorState struct {
 	// workers
 	inRetry map[uint64]retryAttempt
 
+	// ranges keeps track of the on-demand sampling ranges in the order they were scheduled
+	ranges []*rangeState
+
 	// nextJobID is a unique identifier that will be used for creation of next job
 	nextJobID int
 	// all headers before next were sent to workers
@@ -45,6 +51,8 @@ type retryAttempt struct {
 	count int
 	// after specifies the time for the next retry attempt.
 	after time.Time
+	// err is the error of the last failed attempt.
+	err error
 }
 
 // newCoordinatorState initiates state for samplingCoordinator
@@ -77,6 +85,19 @@ func (s *coordinatorState) resumeFromCheckpoint(c checkpoint) {
 			after: time.Now(),
 		}
 	}
+
+	for _, r := range c.Ranges {
+		// heights of the range from Next onwards are sampled again
+		failed := make([]uint64, 0, len(r.Failed))
+		for _, h := range r.Failed {
+			if h < r.Next {
+				failed = append(failed, h)
+			}
+		}
+		r.Failed = failed
+		r.Sampled = r.Next - r.From
+		s.ranges = append(s.ranges, &rangeState{RangeSampling: r, next: r.Next})
+	}
 }
 
 func (s *coordinatorState) handleResult(res result) {
@@ -87,6 +108,8 @@ func (s *coordinatorState) handleResult(res result) {
 		s.handleRecentOrCatchupResult(res)
 	case retryJob:
 		s.handleRetryResult(res)
+	case rangeJob:
+		s.handleRangeResult(res)
 	}
 
 	s.checkDone()
@@ -107,6 +130,7 @@ func (s *coordinatorState) handleRecentOrCatchupResult(res result) {
 	// update failed heights
 	for h := range res.failed {
 		nextRetry, _ := s.retryStrategy.nextRetry(retryAttempt{}, time.Now())
+		nextRetry.err = res.errs[h]
 		s.failed[h] = nextRetry
 	}
 }
@@ -123,6 +147,7 @@ func (s *coordinatorState) handleRetryResult(res result) {
 				"height", h,
 				"attempts", nextRetry.count)
 		}
+		nextRetry.err = res.errs[h]
 		s.failed[h] = nextRetry
 	}
 
@@ -132,6 +157,22 @@ func (s *coordinatorState) handleRetryResult(res result) {
 	}
 }
 
+func (s *coordinatorState) handleRangeResult(res result) {
+	for _, r := range s.ranges {
+		if r.ID != res.rangeID {
+			continue
+		}
+
+		r.jobs--
+		r.Sampled += res.to - res.from + 1
+		for h := range res.failed {
+			r.Failed = append(r.Failed, h)
+		}
+		slices.Sort(r.Failed)
+		return
+	}
+}
+
 func (s *coordinatorState) isNewHead(newHead uint64) bool {
 	// seen this header before
 	if newHead <= s.networkHead {
@@ -167,13 +208,19 @@ func (s *coordinatorState) recentJob(header *header.ExtendedHeader) job {
 	}
 }
 
-// nextJob will return next catchup or retry job according to priority (retry -> catchup)
+// nextJob will return next catchup, range or retry job according to priority (retry -> range ->
+// catchup)
 func (s *coordinatorState) nextJob() (next job, found bool) {
 	// check for if any retry jobs are available
 	if job, found := s.retryJob(); found {
 		return job, found
 	}
 
+	// on-demand ranges take precedence over the catchup
+	if job, found := s.rangeJob(); found {
+		return job, found
+	}
+
 	// if no retry jobs, make a catchup job
 	return s.catchupJob()
 }
@@ -205,12 +252,135 @@ func (s *coordinatorState) retryJob() (next job, found bool) {
 		delete(s.failed, h)
 		s.inRetry[h] = attempt
 		j := s.newJob(retryJob, h, h)
+		j.attempt = attempt.count + 1
 		return j, true
 	}
 
 	return job{}, false
 }
 
+// rangeJob creates a job to sample the next headers of the earliest scheduled on-demand range
+func (s *coordinatorState) rangeJob() (next job, found bool) {
+	for _, r := range s.ranges {
+		if r.next > r.To {
+			continue
+		}
+
+		to := min(r.next+s.samplingRange-1, r.To)
+		j := s.newJob(rangeJob, r.next, to)
+		j.rangeID = r.ID
+		r.next = to + 1
+		r.jobs++
+		return j, true
+	}
+
+	return job{}, false
+}
+
+// addRange schedules the on-demand sampling of the headers from the given range.
+func (s *coordinatorState) addRange(id int, from, to uint64) (RangeSampling, error) {
+	if from == 0 || from > to {
+		return RangeSampling{}, fmt.Errorf("%w: from %d to %d", ErrInvalidRange, from, to)
+	}
+	if to > s.networkHead {
+		return RangeSampling{}, fmt.Errorf("%w: height %d is above the network head %d",
+			ErrInvalidRange, to, s.networkHead)
+	}
+
+	r := &rangeState{
+		RangeSampling: RangeSampling{
+			ID:      id,
+			From:    from,
+			To:      to,
+			Next:    from,
+			Started: time.Now(),
+		},
+		next: from,
+	}
+	s.ranges = append(s.ranges, r)
+	return r.RangeSampling, nil
+}
+
+// finishRange removes the on-demand range with the given ID once all of its headers are sampled and
+// returns its outcome.
+func (s *coordinatorState) finishRange(id int) (RangeSampling, bool) {
+	for i, r := range s.ranges {
+		if r.ID != id {
+			continue
+		}
+		if r.jobs > 0 || r.next <= r.To {
+			return RangeSampling{}, false
+		}
+
+		s.ranges = slices.Delete(s.ranges, i, i+1)
+		r.Next, r.Done, r.Finished = r.next, true, time.Now()
+		return r.RangeSampling, true
+	}
+
+	return RangeSampling{}, false
+}
+
+// failedHeights returns the heights that failed sampling, ordered by height.
+func (s *coordinatorState) failedHeights() []FailedHeight {
+	failed := make([]FailedHeight, 0, len(s.failed)+len(s.inRetry))
+	add := func(h uint64, attempt retryAttempt, inRetry bool) {
+		fh := FailedHeight{
+			Height:    h,
+			Attempts:  attempt.count,
+			NextRetry: attempt.after,
+			InRetry:   inRetry,
+		}
+		if attempt.err != nil {
+			fh.LastError = attempt.err.Error()
+		}
+		failed = append(failed, fh)
+	}
+
+	for h, attempt := range s.failed {
+		add(h, attempt, false)
+	}
+	for h, attempt := range s.inRetry {
+		add(h, attempt, true)
+	}
+
+	slices.SortFunc(failed, func(a, b FailedHeight) int {
+		return cmp.Compare(a.Height, b.Height)
+	})
+	return failed
+}
+
+// retryFailed makes the failed height available for retry immediately, regardless of the backoff.
+func (s *coordinatorState) retryFailed(height uint64) error {
+	if _, ok := s.inRetry[height]; ok {
+		// height is being retried already
+		return nil
+	}
+
+	attempt, ok := s.failed[height]
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrHeightNotFailed, height)
+	}
+
+	attempt.after = time.Time{}
+	s.failed[height] = attempt
+	return nil
+}
+
+// clearFailed forgets the failed height, so that it is not retried anymore.
+func (s *coordinatorState) clearFailed(height uint64) error {
+	if _, ok := s.inRetry[height]; ok {
+		return fmt.Errorf("%w: %d", ErrHeightInRetry, height)
+	}
+
+	if _, ok := s.failed[height]; !ok {
+		return fmt.Errorf("%w: %d", ErrHeightNotFailed, height)
+	}
+
+	delete(s.failed, height)
+	s.checkDone()
+	return nil
+}
+
 func (s *coordinatorState) putInProgress(jobID int, getState func() workerState) {
 	s.inProgress[jobID] = getState
 }
@@ -222,6 +392,7 @@ func (s *coordinatorState) newJob(jobType jobType, from, to uint64) job {
 		jobType: jobType,
 		from:    from,
 		to:      to,
+		attempt: 1,
 	}
 }
 
@@ -230,6 +401,8 @@ func (s *coordinatorState) unsafeStats() SamplingStats {
 	workers := make([]WorkerStats, 0, len(s.inProgress))
 	lowestFailedOrInProgress := s.next
 	failed := make(map[uint64]int)
+	// rangesNext keeps the lowest height in progress of every on-demand range
+	rangesNext := make(map[int]uint64)
 
 	// gather worker stats
 	for _, getStats := range s.inProgress {
@@ -240,12 +413,21 @@ func (s *coordinatorState) unsafeStats() SamplingStats {
 		}
 		workers = append(workers, WorkerStats{
 			JobType: wstats.job.jobType,
+			RangeID: wstats.rangeID,
 			Curr:    wstats.curr,
 			From:    wstats.from,
 			To:      wstats.to,
 			ErrMsg:  errMsg,
 		})
 
+		// on-demand ranges don't affect the progress over the chain
+		if wstats.jobType == rangeJob {
+			if next, ok := rangesNext[wstats.rangeID]; !ok || wstats.curr < next {
+				rangesNext[wstats.rangeID] = wstats.curr
+			}
+			continue
+		}
+
 		for h := range wstats.failed {
 			failed[h]++
 			if h < lowestFailedOrInProgress {
@@ -270,12 +452,24 @@ func (s *coordinatorState) unsafeStats() SamplingStats {
 		failed[h] += retry.count
 	}
 
+	var ranges []RangeSampling
+	for _, r := range s.ranges {
+		rstats := r.RangeSampling
+		rstats.Failed = slices.Clone(r.Failed)
+		rstats.Next = r.next
+		if next, ok := rangesNext[r.ID]; ok && next < rstats.Next {
+			rstats.Next = next
+		}
+		ranges = append(ranges, rstats)
+	}
+
 	return SamplingStats{
 		SampledChainHead: lowestFailedOrInProgress - 1,
 		CatchupHead:      s.next - 1,
 		NetworkHead:      s.networkHead,
 		Failed:           failed,
 		Workers:          workers,
+		Ranges:           ranges,
 		Concurrency:      len(workers),
 		CatchUpDone:      s.catchUpDone.Load(),
 		IsRunning:        len(workers) > 0 || s.catchUpDone.Load(),
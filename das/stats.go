@@ -13,6 +13,8 @@ type SamplingStats struct {
 	Failed map[uint64]int `json:"failed,omitempty"`
 	// Workers has information about each currently running worker stats
 	Workers []WorkerStats `json:"workers,omitempty"`
+	// Ranges has information about the progress of each on-demand sampling range in progress
+	Ranges []RangeSampling `json:"ranges,omitempty"`
 	// Concurrency amount of currently running parallel workers
 	Concurrency int `json:"concurrency"`
 	// CatchUpDone indicates whether all known headers are sampled
@@ -23,9 +25,11 @@ type SamplingStats struct {
 
 type WorkerStats struct {
 	JobType jobType `json:"job_type"`
-	Curr    uint64  `json:"current"`
-	From    uint64  `json:"from"`
-	To      uint64  `json:"to"`
+	// RangeID is the ID of the on-demand sampling range of range jobs
+	RangeID int    `json:"range_id,omitempty"`
+	Curr    uint64 `json:"current"`
+	From    uint64 `json:"from"`
+	To      uint64 `json:"to"`
 
 	ErrMsg string `json:"error,omitempty"`
 }
@@ -34,8 +38,9 @@ type WorkerStats struct {
 func (s SamplingStats) totalSampled() uint64 {
 	var inProgress uint64
 	for _, w := range s.Workers {
-		// don't count recent jobs, since heights they are working on are after catchup head
-		if w.JobType != recentJob {
+		// don't count recent jobs, since heights they are working on are after catchup head, and range
+		// jobs, since heights they are working on could be sampled already
+		if w.JobType != recentJob && w.JobType != rangeJob {
 			inProgress += w.To - w.Curr + 1
 		}
 	}
@@ -3,7 +3,10 @@ package das
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/ipfs/go-datastore"
@@ -13,6 +16,8 @@ import (
 var (
 	storePrefix   = datastore.NewKey("das")
 	checkpointKey = datastore.NewKey("checkpoint")
+	rangeIDKey    = datastore.NewKey("range_id")
+	rangePrefix   = datastore.NewKey("range")
 )
 
 // The checkpointStore stores/loads the DASer's checkpoint to/from
@@ -21,13 +26,17 @@ var (
 type checkpointStore struct {
 	datastore.Datastore
 	done
+
+	// rangeLk serializes the allocation of on-demand sampling range IDs
+	rangeLk sync.Mutex
 }
 
 // newCheckpointStore wraps the given datastore.Datastore with the `das` prefix.
 func newCheckpointStore(ds datastore.Datastore) checkpointStore {
 	return checkpointStore{
-		namespace.Wrap(ds, storePrefix),
-		newDone("checkpoint store")}
+		Datastore: namespace.Wrap(ds, storePrefix),
+		done:      newDone("checkpoint store"),
+	}
 }
 
 // load loads the DAS checkpoint from disk and returns it.
@@ -60,6 +69,50 @@ func (s *checkpointStore) store(ctx context.Context, cp checkpoint) error {
 	return nil
 }
 
+// newRangeID allocates the ID of a new on-demand sampling range. IDs are unique across restarts.
+func (s *checkpointStore) newRangeID(ctx context.Context) (int, error) {
+	s.rangeLk.Lock()
+	defer s.rangeLk.Unlock()
+
+	var id int
+	bs, err := s.Get(ctx, rangeIDKey)
+	if err != nil && !errors.Is(err, datastore.ErrNotFound) {
+		return 0, err
+	}
+	if err == nil {
+		if id, err = strconv.Atoi(string(bs)); err != nil {
+			return 0, fmt.Errorf("parse range id: %w", err)
+		}
+	}
+
+	id++
+	if err = s.Put(ctx, rangeIDKey, []byte(strconv.Itoa(id))); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// loadRange loads the outcome of the finished on-demand sampling range with the given ID.
+func (s *checkpointStore) loadRange(ctx context.Context, id int) (RangeSampling, error) {
+	bs, err := s.Get(ctx, rangePrefix.ChildString(strconv.Itoa(id)))
+	if err != nil {
+		return RangeSampling{}, err
+	}
+
+	r := RangeSampling{}
+	err = json.Unmarshal(bs, &r)
+	return r, err
+}
+
+// storeRange stores the outcome of the finished on-demand sampling range.
+func (s *checkpointStore) storeRange(ctx context.Context, r RangeSampling) error {
+	bs, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("marshal sampling range: %w", err)
+	}
+	return s.Put(ctx, rangePrefix.ChildString(strconv.Itoa(r.ID)), bs)
+}
+
 // runBackgroundStore periodically saves current sampling state in case of DASer force quit before
 // being able to store state on exit. The routine can be disabled by passing storeInterval = 0.
 func (s *checkpointStore) runBackgroundStore(
@@ -10,6 +10,7 @@ import (
 	libhead "github.com/celestiaorg/go-header"
 
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 )
 
@@ -17,6 +18,7 @@ const (
 	catchupJob jobType = "catchup"
 	recentJob  jobType = "recent"
 	retryJob   jobType = "retry"
+	rangeJob   jobType = "range"
 )
 
 type worker struct {
@@ -26,6 +28,7 @@ type worker struct {
 	getter    libhead.Getter[*header.ExtendedHeader]
 	sampleFn  sampleFn
 	broadcast shrexsub.BroadcastFn
+	publish   publishFn
 	metrics   *metrics
 }
 
@@ -46,6 +49,11 @@ type job struct {
 	from    uint64
 	to      uint64
 
+	// rangeID is set only for rangeJobs and identifies the on-demand range the job belongs to
+	rangeID int
+	// attempt is the number of the sampling attempt of the headers of the job, it is greater than 1
+	// only for retryJobs
+	attempt int
 	// header is set only for recentJobs, avoiding an unnecessary call to the header store
 	header *header.ExtendedHeader
 }
@@ -54,18 +62,21 @@ func newWorker(j job,
 	getter libhead.Getter[*header.ExtendedHeader],
 	sample sampleFn,
 	broadcast shrexsub.BroadcastFn,
+	publish publishFn,
 	metrics *metrics,
 ) worker {
 	return worker{
 		getter:    getter,
 		sampleFn:  sample,
 		broadcast: broadcast,
+		publish:   publish,
 		metrics:   metrics,
 		state: workerState{
 			curr: j.from,
 			result: result{
 				job:    j,
 				failed: make(map[uint64]int),
+				errs:   make(map[uint64]error),
 			},
 		},
 	}
@@ -82,6 +93,7 @@ func (w *worker) run(ctx context.Context, timeout time.Duration, resultCh chan<-
 			return
 		}
 		w.setResult(curr, err)
+		w.publishEvent(curr, err)
 	}
 
 	if w.state.jobType != recentJob {
@@ -111,6 +123,11 @@ func (w *worker) sample(ctx context.Context, timeout time.Duration, height uint6
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	// on-demand ranges re-verify the availability of the headers that were sampled before
+	if w.state.jobType == rangeJob {
+		ctx = share.WithRevalidation(ctx)
+	}
+
 	err = w.sampleFn(ctx, h)
 	w.metrics.observeSample(ctx, h, time.Since(start), w.state.jobType, err)
 	if err != nil {
@@ -191,11 +208,27 @@ func (w *worker) setResult(curr uint64, err error) {
 	defer w.lock.Unlock()
 	if err != nil {
 		w.state.failed[curr]++
+		w.state.errs[curr] = err
 		w.state.err = errors.Join(w.state.err, fmt.Errorf("height: %d, err: %w", curr, err))
 	}
 	w.state.curr = curr
 }
 
+// publishEvent notifies the subscribers about the outcome of sampling the header of the given height
+func (w *worker) publishEvent(height uint64, err error) {
+	event := SamplingEvent{
+		Height:  height,
+		JobType: w.state.jobType,
+		RangeID: w.state.rangeID,
+		Attempt: w.state.attempt,
+		Time:    time.Now(),
+	}
+	if err != nil {
+		event.ErrMsg = err.Error()
+	}
+	w.publish(event)
+}
+
 func (w *worker) getState() workerState {
 	w.lock.Lock()
 	defer w.lock.Unlock()
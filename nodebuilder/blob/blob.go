@@ -17,6 +17,21 @@ type Module interface {
 	// Allows sending multiple Blobs atomically synchronously.
 	// Uses default wallet registered on the Node.
 	Submit(_ context.Context, _ []*blob.Blob, _ *blob.SubmitOptions) (height uint64, _ error)
+	// SubmitAsync sends Blobs and returns the hash of the transaction once it is accepted into
+	// the mempool, without waiting for the inclusion.
+	// Uses default wallet registered on the Node.
+	SubmitAsync(_ context.Context, _ []*blob.Blob, _ *blob.SubmitOptions) (txHash string, _ error)
+	// SubmitSplit sends Blobs in as many transactions as needed for them to fit into the square and
+	// reports the height and the transaction hash, or the error, for every Blob. The transactions
+	// are not atomic.
+	// Uses default wallet registered on the Node.
+	SubmitSplit(_ context.Context, _ []*blob.Blob, _ *blob.SubmitOptions) ([]*blob.SubmitResult, error)
+	// SubmitStatus reports whether the Blobs submitted within the transaction with the given hash
+	// are pending, included or failed.
+	SubmitStatus(_ context.Context, txHash string) (*blob.SubmitStatus, error)
+	// WaitSubmitted blocks until the transaction with the given hash is committed and reports the
+	// status of the submitted Blobs.
+	WaitSubmitted(_ context.Context, txHash string) (*blob.SubmitStatus, error)
 	// Get retrieves the blob by commitment under the given namespace and height.
 	Get(_ context.Context, height uint64, _ share.Namespace, _ blob.Commitment) (*blob.Blob, error)
 	// GetAll returns all blobs under the given namespaces and height.
@@ -26,15 +41,29 @@ type Module interface {
 	// Included checks whether a blob's given commitment(Merkle subtree root) is included at
 	// given height and under the namespace.
 	Included(_ context.Context, height uint64, _ share.Namespace, _ *blob.Proof, _ blob.Commitment) (bool, error)
+	// Subscribe streams blobs under the given namespace for every new height, starting from the
+	// given height, or from the next network height if it is 0. Heights without blobs under the
+	// namespace are reported with no blobs.
+	Subscribe(_ context.Context, _ share.Namespace, fromHeight uint64) (<-chan *blob.SubscriptionResponse, error)
 }
 
 type API struct {
 	Internal struct {
-		Submit   func(context.Context, []*blob.Blob, *blob.SubmitOptions) (uint64, error)                   `perm:"write"`
-		Get      func(context.Context, uint64, share.Namespace, blob.Commitment) (*blob.Blob, error)        `perm:"read"`
-		GetAll   func(context.Context, uint64, []share.Namespace) ([]*blob.Blob, error)                     `perm:"read"`
-		GetProof func(context.Context, uint64, share.Namespace, blob.Commitment) (*blob.Proof, error)       `perm:"read"`
-		Included func(context.Context, uint64, share.Namespace, *blob.Proof, blob.Commitment) (bool, error) `perm:"read"`
+		Submit      func(context.Context, []*blob.Blob, *blob.SubmitOptions) (uint64, error) `perm:"write"`
+		SubmitAsync func(context.Context, []*blob.Blob, *blob.SubmitOptions) (string, error) `perm:"write"`
+		SubmitSplit func(
+			context.Context,
+			[]*blob.Blob,
+			*blob.SubmitOptions,
+		) ([]*blob.SubmitResult, error) `perm:"write"`
+
+		SubmitStatus  func(context.Context, string) (*blob.SubmitStatus, error)                                  `perm:"read"`
+		WaitSubmitted func(context.Context, string) (*blob.SubmitStatus, error)                                  `perm:"read"`
+		Get           func(context.Context, uint64, share.Namespace, blob.Commitment) (*blob.Blob, error)        `perm:"read"`
+		GetAll        func(context.Context, uint64, []share.Namespace) ([]*blob.Blob, error)                     `perm:"read"`
+		GetProof      func(context.Context, uint64, share.Namespace, blob.Commitment) (*blob.Proof, error)       `perm:"read"`
+		Included      func(context.Context, uint64, share.Namespace, *blob.Proof, blob.Commitment) (bool, error) `perm:"read"`
+		Subscribe     func(context.Context, share.Namespace, uint64) (<-chan *blob.SubscriptionResponse, error)  `perm:"read"`
 	}
 }
 
@@ -42,6 +71,26 @@ func (api *API) Submit(ctx context.Context, blobs []*blob.Blob, options *blob.Su
 	return api.Internal.Submit(ctx, blobs, options)
 }
 
+func (api *API) SubmitAsync(ctx context.Context, blobs []*blob.Blob, options *blob.SubmitOptions) (string, error) {
+	return api.Internal.SubmitAsync(ctx, blobs, options)
+}
+
+func (api *API) SubmitSplit(
+	ctx context.Context,
+	blobs []*blob.Blob,
+	options *blob.SubmitOptions,
+) ([]*blob.SubmitResult, error) {
+	return api.Internal.SubmitSplit(ctx, blobs, options)
+}
+
+func (api *API) SubmitStatus(ctx context.Context, txHash string) (*blob.SubmitStatus, error) {
+	return api.Internal.SubmitStatus(ctx, txHash)
+}
+
+func (api *API) WaitSubmitted(ctx context.Context, txHash string) (*blob.SubmitStatus, error) {
+	return api.Internal.WaitSubmitted(ctx, txHash)
+}
+
 func (api *API) Get(
 	ctx context.Context,
 	height uint64,
@@ -73,3 +122,11 @@ func (api *API) Included(
 ) (bool, error) {
 	return api.Internal.Included(ctx, height, namespace, proof, commitment)
 }
+
+func (api *API) Subscribe(
+	ctx context.Context,
+	namespace share.Namespace,
+	fromHeight uint64,
+) (<-chan *blob.SubscriptionResponse, error) {
+	return api.Internal.Subscribe(ctx, namespace, fromHeight)
+}
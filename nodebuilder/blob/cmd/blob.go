@@ -18,6 +18,8 @@ var (
 
 	fee      int64
 	gasLimit uint64
+
+	account string
 )
 
 func init() {
@@ -52,6 +54,14 @@ func init() {
 		"sets the amount of gas that is consumed during blob submission [optional]",
 	)
 
+	submitCmd.PersistentFlags().StringVar(
+		&account,
+		"account",
+		"",
+		"specifies the key name or the address of the node's account that pays for the blob.\n"+
+			"The default account is used if it is not set [optional]",
+	)
+
 	// unset the default value to avoid users confusion
 	submitCmd.PersistentFlags().Lookup("fee").DefValue = "0"
 }
@@ -156,7 +166,7 @@ var submitCmd = &cobra.Command{
 		height, err := client.Blob.Submit(
 			cmd.Context(),
 			[]*blob.Blob{parsedBlob},
-			&blob.SubmitOptions{Fee: fee, GasLimit: gasLimit},
+			&blob.SubmitOptions{Fee: fee, GasLimit: gasLimit, Account: account},
 		)
 
 		response := struct {
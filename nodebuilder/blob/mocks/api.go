@@ -111,3 +111,78 @@ func (mr *MockModuleMockRecorder) Submit(arg0, arg1, arg2 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockModule)(nil).Submit), arg0, arg1, arg2)
 }
+
+// SubmitAsync mocks base method.
+func (m *MockModule) SubmitAsync(arg0 context.Context, arg1 []*blob.Blob, arg2 *blob.SubmitOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitAsync", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAsync indicates an expected call of SubmitAsync.
+func (mr *MockModuleMockRecorder) SubmitAsync(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAsync", reflect.TypeOf((*MockModule)(nil).SubmitAsync), arg0, arg1, arg2)
+}
+
+// SubmitSplit mocks base method.
+func (m *MockModule) SubmitSplit(arg0 context.Context, arg1 []*blob.Blob, arg2 *blob.SubmitOptions) ([]*blob.SubmitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitSplit", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*blob.SubmitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitSplit indicates an expected call of SubmitSplit.
+func (mr *MockModuleMockRecorder) SubmitSplit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSplit", reflect.TypeOf((*MockModule)(nil).SubmitSplit), arg0, arg1, arg2)
+}
+
+// SubmitStatus mocks base method.
+func (m *MockModule) SubmitStatus(arg0 context.Context, arg1 string) (*blob.SubmitStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitStatus", arg0, arg1)
+	ret0, _ := ret[0].(*blob.SubmitStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitStatus indicates an expected call of SubmitStatus.
+func (mr *MockModuleMockRecorder) SubmitStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitStatus", reflect.TypeOf((*MockModule)(nil).SubmitStatus), arg0, arg1)
+}
+
+// Subscribe mocks base method.
+func (m *MockModule) Subscribe(arg0 context.Context, arg1 share.Namespace, arg2 uint64) (<-chan *blob.SubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(<-chan *blob.SubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockModuleMockRecorder) Subscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockModule)(nil).Subscribe), arg0, arg1, arg2)
+}
+
+// WaitSubmitted mocks base method.
+func (m *MockModule) WaitSubmitted(arg0 context.Context, arg1 string) (*blob.SubmitStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitSubmitted", arg0, arg1)
+	ret0, _ := ret[0].(*blob.SubmitStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitSubmitted indicates an expected call of WaitSubmitted.
+func (mr *MockModuleMockRecorder) WaitSubmitted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitSubmitted", reflect.TypeOf((*MockModule)(nil).WaitSubmitted), arg0, arg1)
+}
@@ -18,11 +18,16 @@ func ConstructModule() fx.Option {
 			func(service headerService.Module) func(context.Context, uint64) (*header.ExtendedHeader, error) {
 				return service.GetByHeight
 			}),
+		fx.Provide(
+			func(service headerService.Module) func(context.Context) (<-chan *header.ExtendedHeader, error) {
+				return service.Subscribe
+			}),
 		fx.Provide(func(
 			state *state.CoreAccessor,
 			sGetter share.Getter,
 			getByHeightFn func(context.Context, uint64) (*header.ExtendedHeader, error),
+			subscribeFn func(context.Context) (<-chan *header.ExtendedHeader, error),
 		) Module {
-			return blob.NewService(state, sGetter, getByHeightFn)
+			return blob.NewService(state, sGetter, getByHeightFn, subscribeFn)
 		}))
 }
@@ -5,10 +5,15 @@ import (
 	"testing"
 
 	"github.com/BurntSushi/toml"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/cache"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 )
 
 // TestConfigWriteRead tests that the configs for all node types can be encoded to and from TOML.
@@ -57,6 +62,28 @@ func TestUpdateConfig(t *testing.T) {
 	require.True(t, cfg.Gateway.Enabled)
 }
 
+// TestOutdatedEDSStoreParams tests that the share config, including the EDS store parameters, of a
+// config written before the storage type, the cache policy and shrex/sample were introduced is
+// still valid.
+func TestOutdatedEDSStoreParams(t *testing.T) {
+	cfg := new(Config)
+	_, err := toml.Decode(outdatedFullConfig, cfg)
+	require.NoError(t, err)
+
+	require.Nil(t, cfg.Share.ShrExSampleParams)
+	require.NoError(t, cfg.Share.Validate(node.Full))
+	require.Equal(t, shrexsample.DefaultParameters(), cfg.Share.ShrExSampleParams)
+
+	params := cfg.Share.EDSStoreParams
+	require.Empty(t, params.Storage)
+	require.Empty(t, params.CachePolicy)
+	require.NoError(t, params.Validate())
+	require.Equal(t, cache.LRUPolicy, params.CachePolicy)
+
+	_, err = eds.NewStore(params, t.TempDir(), ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+}
+
 // outdatedConfig is an outdated config from a light node
 var outdatedConfig = `
 [Core]
@@ -135,3 +162,35 @@ var outdatedConfig = `
   SampleFrom = 1
   SampleTimeout = "4m0s"
 `
+
+// outdatedFullConfig is the share section of an outdated config from a full node
+var outdatedFullConfig = `
+[Share]
+  UseShareExchange = true
+  [Share.EDSStoreParams]
+    GCInterval = "0s"
+    RecentBlocksCacheSize = 10
+    BlockstoreCacheSize = 128
+    RetentionHeights = 0
+    RetentionPeriod = "0s"
+    PruningInterval = "5m0s"
+  [Share.ShrExEDSParams]
+    ServerReadTimeout = "5s"
+    ServerWriteTimeout = "1m0s"
+    HandleRequestTimeout = "1m0s"
+    ConcurrencyLimit = 10
+    BufferSize = 32768
+  [Share.ShrExNDParams]
+    ServerReadTimeout = "5s"
+    ServerWriteTimeout = "1m0s"
+    HandleRequestTimeout = "1m0s"
+    ConcurrencyLimit = 10
+  [Share.PeerManagerParams]
+    PoolValidationTimeout = "2m0s"
+    PeerCooldown = "3s"
+    GcInterval = "30s"
+    EnableBlackListing = false
+  [Share.Discovery]
+    PeersLimit = 5
+    AdvertiseInterval = "1h0m0s"
+`
@@ -1,13 +1,23 @@
 package cmd
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	cmdnode "github.com/celestiaorg/celestia-node/cmd"
 )
 
 func init() {
-	Cmd.AddCommand(samplingStatsCmd)
+	Cmd.AddCommand(
+		samplingStatsCmd,
+		samplingResultCmd,
+		sampleRangeCmd,
+		rangeSamplingCmd,
+		failedHeightsCmd,
+		retryFailedCmd,
+		clearFailedCmd,
+	)
 }
 
 var Cmd = &cobra.Command{
@@ -32,3 +42,134 @@ var samplingStatsCmd = &cobra.Command{
 		return cmdnode.PrintOutput(stats, err, nil)
 	},
 }
+
+var samplingResultCmd = &cobra.Command{
+	Use:   "sampling-result [height]",
+	Short: "Returns the samples of the last sampling round over the header of the given height and their outcomes",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		height, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		result, err := client.DAS.SamplingResult(cmd.Context(), height)
+		return cmdnode.PrintOutput(result, err, nil)
+	},
+}
+
+var sampleRangeCmd = &cobra.Command{
+	Use:   "sample-range [from] [to]",
+	Short: "Schedules the sampling of the headers from the given range, including already sampled ones",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		from, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		to, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		r, err := client.DAS.SampleRange(cmd.Context(), from, to)
+		return cmdnode.PrintOutput(r, err, nil)
+	},
+}
+
+var rangeSamplingCmd = &cobra.Command{
+	Use:   "range-sampling [id]",
+	Short: "Returns the progress or the outcome of the sampling range with the given ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+
+		r, err := client.DAS.RangeSampling(cmd.Context(), id)
+		return cmdnode.PrintOutput(r, err, nil)
+	},
+}
+
+var failedHeightsCmd = &cobra.Command{
+	Use:   "failed-heights",
+	Short: "Lists the heights that failed sampling with their attempts, last errors and next retry time",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		failed, err := client.DAS.FailedHeights(cmd.Context())
+		return cmdnode.PrintOutput(failed, err, nil)
+	},
+}
+
+var retryFailedCmd = &cobra.Command{
+	Use:   "retry-failed [height]",
+	Short: "Retries sampling of the failed height immediately",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		height, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		err = client.DAS.RetryFailed(cmd.Context(), height)
+		if err != nil {
+			return cmdnode.PrintOutput(nil, err, nil)
+		}
+		return failedHeightsCmd.RunE(cmd, nil)
+	},
+}
+
+var clearFailedCmd = &cobra.Command{
+	Use:   "clear-failed [height]",
+	Short: "Stops retrying sampling of the failed height",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		height, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		err = client.DAS.ClearFailed(cmd.Context(), height)
+		if err != nil {
+			return cmdnode.PrintOutput(nil, err, nil)
+		}
+		return failedHeightsCmd.RunE(cmd, nil)
+	},
+}
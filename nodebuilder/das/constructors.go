@@ -29,6 +29,34 @@ func (d daserStub) SamplingStats(context.Context) (das.SamplingStats, error) {
 	return das.SamplingStats{}, errStub
 }
 
+func (d daserStub) SamplingResult(context.Context, uint64) (*share.SamplingResult, error) {
+	return nil, errStub
+}
+
+func (d daserStub) SampleRange(context.Context, uint64, uint64) (*das.RangeSampling, error) {
+	return nil, errStub
+}
+
+func (d daserStub) RangeSampling(context.Context, int) (*das.RangeSampling, error) {
+	return nil, errStub
+}
+
+func (d daserStub) FailedHeights(context.Context) ([]das.FailedHeight, error) {
+	return nil, errStub
+}
+
+func (d daserStub) RetryFailed(context.Context, uint64) error {
+	return errStub
+}
+
+func (d daserStub) ClearFailed(context.Context, uint64) error {
+	return errStub
+}
+
+func (d daserStub) Subscribe(context.Context) (<-chan *das.SamplingEvent, error) {
+	return nil, errStub
+}
+
 func (d daserStub) WaitCatchUp(context.Context) error {
 	return errStub
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/celestiaorg/celestia-node/das"
+	"github.com/celestiaorg/celestia-node/share"
 )
 
 var _ Module = (*API)(nil)
@@ -12,6 +13,23 @@ var _ Module = (*API)(nil)
 type Module interface {
 	// SamplingStats returns the current statistics over the DA sampling process.
 	SamplingStats(ctx context.Context) (das.SamplingStats, error)
+	// SamplingResult returns the coordinates, outcomes, sources and latencies of the samples of
+	// the last sampling round over the header of the given height.
+	SamplingResult(ctx context.Context, height uint64) (*share.SamplingResult, error)
+	// SampleRange schedules the sampling of the headers from the given range, including the ones
+	// that were sampled before. Its progress is reported by SamplingStats.
+	SampleRange(ctx context.Context, from, to uint64) (*das.RangeSampling, error)
+	// RangeSampling returns the progress or the outcome of the sampling range with the given ID.
+	RangeSampling(ctx context.Context, id int) (*das.RangeSampling, error)
+	// FailedHeights returns the heights that failed sampling together with their amount of
+	// attempts, last errors and the time of the next retry.
+	FailedHeights(ctx context.Context) ([]das.FailedHeight, error)
+	// RetryFailed retries sampling of the failed height immediately, regardless of its backoff.
+	RetryFailed(ctx context.Context, height uint64) error
+	// ClearFailed stops retrying sampling of the failed height.
+	ClearFailed(ctx context.Context, height uint64) error
+	// Subscribe streams the outcome of sampling of every header as soon as it is known.
+	Subscribe(ctx context.Context) (<-chan *das.SamplingEvent, error)
 	// WaitCatchUp blocks until DASer finishes catching up to the network head.
 	WaitCatchUp(ctx context.Context) error
 }
@@ -20,8 +38,15 @@ type Module interface {
 // TODO(@distractedm1nd): These structs need to be autogenerated.
 type API struct {
 	Internal struct {
-		SamplingStats func(ctx context.Context) (das.SamplingStats, error) `perm:"read"`
-		WaitCatchUp   func(ctx context.Context) error                      `perm:"read"`
+		SamplingStats  func(ctx context.Context) (das.SamplingStats, error)                    `perm:"read"`
+		SamplingResult func(ctx context.Context, height uint64) (*share.SamplingResult, error) `perm:"read"`
+		SampleRange    func(ctx context.Context, from, to uint64) (*das.RangeSampling, error)  `perm:"admin"`
+		RangeSampling  func(ctx context.Context, id int) (*das.RangeSampling, error)           `perm:"read"`
+		FailedHeights  func(ctx context.Context) ([]das.FailedHeight, error)                   `perm:"read"`
+		RetryFailed    func(ctx context.Context, height uint64) error                          `perm:"admin"`
+		ClearFailed    func(ctx context.Context, height uint64) error                          `perm:"admin"`
+		Subscribe      func(ctx context.Context) (<-chan *das.SamplingEvent, error)            `perm:"read"`
+		WaitCatchUp    func(ctx context.Context) error                                         `perm:"read"`
 	}
 }
 
@@ -29,6 +54,34 @@ func (api *API) SamplingStats(ctx context.Context) (das.SamplingStats, error) {
 	return api.Internal.SamplingStats(ctx)
 }
 
+func (api *API) SamplingResult(ctx context.Context, height uint64) (*share.SamplingResult, error) {
+	return api.Internal.SamplingResult(ctx, height)
+}
+
+func (api *API) SampleRange(ctx context.Context, from, to uint64) (*das.RangeSampling, error) {
+	return api.Internal.SampleRange(ctx, from, to)
+}
+
+func (api *API) RangeSampling(ctx context.Context, id int) (*das.RangeSampling, error) {
+	return api.Internal.RangeSampling(ctx, id)
+}
+
+func (api *API) FailedHeights(ctx context.Context) ([]das.FailedHeight, error) {
+	return api.Internal.FailedHeights(ctx)
+}
+
+func (api *API) RetryFailed(ctx context.Context, height uint64) error {
+	return api.Internal.RetryFailed(ctx, height)
+}
+
+func (api *API) ClearFailed(ctx context.Context, height uint64) error {
+	return api.Internal.ClearFailed(ctx, height)
+}
+
+func (api *API) Subscribe(ctx context.Context) (<-chan *das.SamplingEvent, error) {
+	return api.Internal.Subscribe(ctx)
+}
+
 func (api *API) WaitCatchUp(ctx context.Context) error {
 	return api.Internal.WaitCatchUp(ctx)
 }
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 
 	das "github.com/celestiaorg/celestia-node/das"
+	share "github.com/celestiaorg/celestia-node/share"
 )
 
 // MockModule is a mock of Module interface.
@@ -36,6 +37,94 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 	return m.recorder
 }
 
+// ClearFailed mocks base method.
+func (m *MockModule) ClearFailed(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearFailed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearFailed indicates an expected call of ClearFailed.
+func (mr *MockModuleMockRecorder) ClearFailed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearFailed", reflect.TypeOf((*MockModule)(nil).ClearFailed), arg0, arg1)
+}
+
+// FailedHeights mocks base method.
+func (m *MockModule) FailedHeights(arg0 context.Context) ([]das.FailedHeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailedHeights", arg0)
+	ret0, _ := ret[0].([]das.FailedHeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailedHeights indicates an expected call of FailedHeights.
+func (mr *MockModuleMockRecorder) FailedHeights(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailedHeights", reflect.TypeOf((*MockModule)(nil).FailedHeights), arg0)
+}
+
+// RangeSampling mocks base method.
+func (m *MockModule) RangeSampling(arg0 context.Context, arg1 int) (*das.RangeSampling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSampling", arg0, arg1)
+	ret0, _ := ret[0].(*das.RangeSampling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSampling indicates an expected call of RangeSampling.
+func (mr *MockModuleMockRecorder) RangeSampling(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSampling", reflect.TypeOf((*MockModule)(nil).RangeSampling), arg0, arg1)
+}
+
+// RetryFailed mocks base method.
+func (m *MockModule) RetryFailed(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryFailed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryFailed indicates an expected call of RetryFailed.
+func (mr *MockModuleMockRecorder) RetryFailed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailed", reflect.TypeOf((*MockModule)(nil).RetryFailed), arg0, arg1)
+}
+
+// SampleRange mocks base method.
+func (m *MockModule) SampleRange(arg0 context.Context, arg1, arg2 uint64) (*das.RangeSampling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SampleRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*das.RangeSampling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SampleRange indicates an expected call of SampleRange.
+func (mr *MockModuleMockRecorder) SampleRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SampleRange", reflect.TypeOf((*MockModule)(nil).SampleRange), arg0, arg1, arg2)
+}
+
+// SamplingResult mocks base method.
+func (m *MockModule) SamplingResult(arg0 context.Context, arg1 uint64) (*share.SamplingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SamplingResult", arg0, arg1)
+	ret0, _ := ret[0].(*share.SamplingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SamplingResult indicates an expected call of SamplingResult.
+func (mr *MockModuleMockRecorder) SamplingResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SamplingResult", reflect.TypeOf((*MockModule)(nil).SamplingResult), arg0, arg1)
+}
+
 // SamplingStats mocks base method.
 func (m *MockModule) SamplingStats(arg0 context.Context) (das.SamplingStats, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +140,21 @@ func (mr *MockModuleMockRecorder) SamplingStats(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SamplingStats", reflect.TypeOf((*MockModule)(nil).SamplingStats), arg0)
 }
 
+// Subscribe mocks base method.
+func (m *MockModule) Subscribe(arg0 context.Context) (<-chan *das.SamplingEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0)
+	ret0, _ := ret[0].(<-chan *das.SamplingEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockModuleMockRecorder) Subscribe(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockModule)(nil).Subscribe), arg0)
+}
+
 // WaitCatchUp mocks base method.
 func (m *MockModule) WaitCatchUp(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -1,6 +1,7 @@
 package nodebuilder
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -73,8 +74,8 @@ func Init(cfg Config, path string, tp node.Type) error {
 	return nil
 }
 
-// Reset removes all data from the datastore and dagstore directories. It leaves the keystore and
-// config intact.
+// Reset removes all data from the datastore and dagstore directories, including the EDSes in the
+// cold storage tier. It leaves the keystore and config intact.
 func Reset(path string, tp node.Type) error {
 	path, err := storePath(path)
 	if err != nil {
@@ -91,6 +92,16 @@ func Reset(path string, tp node.Type) error {
 	}
 	defer flock.Unlock() //nolint: errcheck
 
+	// the config is read before anything is removed, so that a broken config doesn't leave the store
+	// reset halfway
+	var coldPath string
+	if tp != node.Light {
+		coldPath, err = coldStoragePath(path)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = resetDir(dataPath(path))
 	if err != nil {
 		return err
@@ -117,6 +128,21 @@ func Reset(path string, tp node.Type) error {
 		return err
 	}
 
+	err = resetDir(odsPath(path))
+	if err != nil {
+		return err
+	}
+
+	err = resetDir(quarantinePath(path))
+	if err != nil {
+		return err
+	}
+
+	err = resetColdStorage(coldPath)
+	if err != nil {
+		return err
+	}
+
 	log.Info("Node Store reset")
 	return nil
 }
@@ -167,6 +193,37 @@ func initRoot(path string) error {
 	return os.Remove(f.Name())
 }
 
+// coldStoragePath returns the path of the cold storage tier configured for the store. The store
+// without a config has no cold storage tier.
+func coldStoragePath(path string) (string, error) {
+	cfg, err := LoadConfig(configPath(path))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("loading config: %w", err)
+	case cfg.Share.EDSStoreParams == nil:
+		return "", nil
+	}
+	return cfg.Share.EDSStoreParams.ColdStoragePath, nil
+}
+
+// resetColdStorage removes the EDSes moved to the cold storage tier under the given path, if any.
+// The rest of the cold storage directory is left intact, as it may be shared with other data.
+func resetColdStorage(coldPath string) error {
+	if coldPath == "" {
+		return nil
+	}
+
+	// the cold storage tier mirrors the layout of the store
+	for _, dir := range []string{blocksPath(coldPath), odsPath(coldPath)} {
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // resetDir removes all files from the given directory and reinitializes it
 func resetDir(path string) error {
 	err := os.RemoveAll(path)
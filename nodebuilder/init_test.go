@@ -2,6 +2,7 @@ package nodebuilder
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -28,6 +29,44 @@ func TestInit(t *testing.T) {
 	}
 }
 
+func TestReset_ColdStorage(t *testing.T) {
+	dir, coldDir := t.TempDir(), t.TempDir()
+	cfg := DefaultConfig(node.Full)
+	cfg.Share.EDSStoreParams.ColdStoragePath = coldDir
+	require.NoError(t, Init(*cfg, dir, node.Full))
+
+	// an EDS moved to the cold storage tier and a file unrelated to the store
+	require.NoError(t, os.MkdirAll(blocksPath(coldDir), perms))
+	require.NoError(t, os.WriteFile(filepath.Join(blocksPath(coldDir), "eds"), nil, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(coldDir, "other"), nil, 0600))
+
+	require.NoError(t, Reset(dir, node.Full))
+	assert.NoDirExists(t, blocksPath(coldDir))
+	assert.FileExists(t, filepath.Join(coldDir, "other"))
+}
+
+func TestReset_NoConfig(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Init(*DefaultConfig(node.Full), dir, node.Full))
+	require.NoError(t, os.Remove(configPath(dir)))
+	require.NoError(t, os.WriteFile(filepath.Join(dataPath(dir), "entry"), nil, 0600))
+
+	require.NoError(t, Reset(dir, node.Full))
+	assert.NoFileExists(t, filepath.Join(dataPath(dir), "entry"))
+	assert.DirExists(t, dataPath(dir))
+}
+
+func TestReset_BrokenConfig(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Init(*DefaultConfig(node.Full), dir, node.Full))
+	require.NoError(t, os.WriteFile(configPath(dir), []byte("invalid toml"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dataPath(dir), "entry"), nil, 0600))
+
+	// nothing is removed if the cold storage tier is unknown
+	require.Error(t, Reset(dir, node.Full))
+	assert.FileExists(t, filepath.Join(dataPath(dir), "entry"))
+}
+
 func TestInitErrForInvalidPath(t *testing.T) {
 	path := "/invalid_path"
 	nodes := []node.Type{node.Light, node.Bridge}
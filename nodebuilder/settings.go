@@ -103,6 +103,8 @@ func WithMetrics(metricOpts []otlpmetrichttp.Option, nodeType node.Type) fx.Opti
 		opts = fx.Options(
 			baseComponents,
 			fx.Invoke(share.WithStoreMetrics),
+			fx.Invoke(share.WithPrunerMetrics),
+			fx.Invoke(share.WithScannerMetrics),
 			fx.Invoke(share.WithShrexServerMetrics),
 			samplingMetrics,
 		)
@@ -115,6 +117,8 @@ func WithMetrics(metricOpts []otlpmetrichttp.Option, nodeType node.Type) fx.Opti
 		opts = fx.Options(
 			baseComponents,
 			fx.Invoke(share.WithStoreMetrics),
+			fx.Invoke(share.WithPrunerMetrics),
+			fx.Invoke(share.WithScannerMetrics),
 			fx.Invoke(share.WithShrexServerMetrics),
 		)
 	default:
@@ -3,21 +3,43 @@ package cmd
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/celestiaorg/rsmt2d"
+
 	cmdnode "github.com/celestiaorg/celestia-node/cmd"
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
 )
 
+var fullFlag bool
+
 func init() {
 	Cmd.AddCommand(
 		sharesAvailableCmd,
 		getSharesByNamespaceCmd,
+		getSharesByNamespaceRangeCmd,
 		getShare,
+		getRow,
 		getEDS,
+		getODS,
+		storeHealth,
+		cacheStats,
+	)
+
+	getRow.PersistentFlags().BoolVar(
+		&fullFlag,
+		"full",
+		false,
+		"prints the whole row or column recovered from its first half [optional]",
 	)
 }
 
@@ -103,6 +125,42 @@ var getSharesByNamespaceCmd = &cobra.Command{
 	},
 }
 
+var getSharesByNamespaceRangeCmd = &cobra.Command{
+	Use:   "get-by-namespace-range [extended header, min namespace, max namespace]",
+	Short: "Gets all shares from an EDS within any of the namespaces between min and max, inclusive.",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		raw, err := parseJSON(args[0])
+		if err != nil {
+			return err
+		}
+
+		var eh *header.ExtendedHeader
+		err = json.Unmarshal(raw, &eh)
+		if err != nil {
+			return err
+		}
+
+		minNs, err := cmdnode.ParseV0Namespace(args[1])
+		if err != nil {
+			return err
+		}
+		maxNs, err := cmdnode.ParseV0Namespace(args[2])
+		if err != nil {
+			return err
+		}
+
+		shares, err := client.Share.GetSharesByNamespaceRange(cmd.Context(), eh, minNs, maxNs)
+		return cmdnode.PrintOutput(shares, err, nil)
+	},
+}
+
 var getShare = &cobra.Command{
 	Use:   "get-share [extended header, row, col]",
 	Short: "Gets a Share by coordinates in EDS.",
@@ -157,6 +215,50 @@ var getShare = &cobra.Command{
 	},
 }
 
+var getRow = &cobra.Command{
+	Use:   "get-row [extended header, index, axis]",
+	Short: "Gets the first half of the row or column with the given index in EDS along with its proof.",
+	Long: "Gets the first half of the row or column with the given index in EDS along with its proof.\n" +
+		"The axis is either 'row' or 'col'. The whole row or column is returned with the '--full' flag.",
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		raw, err := parseJSON(args[0])
+		if err != nil {
+			return err
+		}
+
+		var eh *header.ExtendedHeader
+		err = json.Unmarshal(raw, &eh)
+		if err != nil {
+			return err
+		}
+
+		idx, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		axis, err := parseAxis(args[2])
+		if err != nil {
+			return err
+		}
+
+		half, err := client.Share.GetRow(cmd.Context(), eh, int(idx), axis)
+		if err != nil || !fullFlag {
+			return cmdnode.PrintOutput(half, err, nil)
+		}
+
+		shares, err := half.Extend()
+		return cmdnode.PrintOutput(shares, err, nil)
+	},
+}
+
 var getEDS = &cobra.Command{
 	Use:   "get-eds [extended header]",
 	Short: "Gets the full EDS identified by the given extended header",
@@ -184,6 +286,123 @@ var getEDS = &cobra.Command{
 	},
 }
 
+var storeHealth = &cobra.Command{
+	Use:   "store-health",
+	Short: "Reports the results of the integrity scans of the node's EDS store",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		health, err := client.Share.StoreHealth(cmd.Context())
+		return cmdnode.PrintOutput(health, err, nil)
+	},
+}
+
+var cacheStats = &cobra.Command{
+	Use:   "cache-stats",
+	Short: "Reports the contents and the hit ratios of the accessor caches of the node's EDS store",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		stats, err := client.Share.CacheStats(cmd.Context())
+		return cmdnode.PrintOutput(stats, err, nil)
+	},
+}
+
+var getODS = &cobra.Command{
+	Use:   "get-ods [extended header, file]",
+	Short: "Downloads the ODS identified by the given extended header into the file as CAR, verifying it row by row",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		raw, err := parseJSON(args[0])
+		if err != nil {
+			return err
+		}
+
+		var eh *header.ExtendedHeader
+		err = json.Unmarshal(raw, &eh)
+		if err != nil {
+			return err
+		}
+
+		odsCh, err := client.Share.GetODS(cmd.Context(), eh)
+		if err != nil {
+			return cmdnode.PrintOutput(nil, err, nil)
+		}
+
+		file, err := os.Create(args[1])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		// verify the rows while they are written to the file
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		go func() {
+			defer pw.Close()
+			for chunk := range odsCh {
+				if _, err := pw.Write(chunk); err != nil {
+					return
+				}
+			}
+		}()
+
+		rowReader, err := eds.NewODSRowReader(io.TeeReader(pr, file), eh.DAH)
+		if err != nil {
+			return cmdnode.PrintOutput(nil, err, nil)
+		}
+		rows := 0
+		for {
+			_, _, err = rowReader.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return cmdnode.PrintOutput(nil, err, nil)
+			}
+			rows++
+		}
+
+		formatter := func(data interface{}) interface{} {
+			return struct {
+				File string `json:"file"`
+				Rows int    `json:"rows"`
+			}{
+				File: args[1],
+				Rows: data.(int),
+			}
+		}
+		return cmdnode.PrintOutput(rows, nil, formatter)
+	},
+}
+
+func parseAxis(param string) (rsmt2d.Axis, error) {
+	switch strings.ToLower(param) {
+	case "row":
+		return rsmt2d.Row, nil
+	case "col", "column":
+		return rsmt2d.Col, nil
+	default:
+		return 0, fmt.Errorf("invalid axis %q: must be either 'row' or 'col'", param)
+	}
+}
+
 func parseJSON(param string) (json.RawMessage, error) {
 	var raw json.RawMessage
 	err := json.Unmarshal([]byte(param), &raw)
@@ -10,6 +10,7 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 )
 
 // TODO: some params are pointers and other are not, Let's fix this.
@@ -22,6 +23,8 @@ type Config struct {
 	ShrExEDSParams *shrexeds.Parameters
 	// ShrExNDParams sets shrexnd client and server configuration parameters
 	ShrExNDParams *shrexnd.Parameters
+	// ShrExSampleParams sets shrexsample client and server configuration parameters
+	ShrExSampleParams *shrexsample.Parameters
 	// PeerManagerParams sets peer-manager configuration parameters
 	PeerManagerParams peers.Parameters
 
@@ -35,6 +38,7 @@ func DefaultConfig(tp node.Type) Config {
 		Discovery:         discovery.DefaultParameters(),
 		ShrExEDSParams:    shrexeds.DefaultParameters(),
 		ShrExNDParams:     shrexnd.DefaultParameters(),
+		ShrExSampleParams: shrexsample.DefaultParameters(),
 		UseShareExchange:  true,
 		PeerManagerParams: peers.DefaultParameters(),
 	}
@@ -62,6 +66,14 @@ func (cfg *Config) Validate(tp node.Type) error {
 		return fmt.Errorf("nodebuilder/share: %w", err)
 	}
 
+	// configs written before shrex/sample was introduced don't set its parameters
+	if cfg.ShrExSampleParams == nil {
+		cfg.ShrExSampleParams = shrexsample.DefaultParameters()
+	}
+	if err := cfg.ShrExSampleParams.Validate(); err != nil {
+		return fmt.Errorf("nodebuilder/share: %w", err)
+	}
+
 	if err := cfg.ShrExEDSParams.Validate(); err != nil {
 		return fmt.Errorf("nodebuilder/share: %w", err)
 	}
@@ -3,15 +3,18 @@ package share
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/filecoin-project/dagstore"
 	"github.com/ipfs/boxo/blockservice"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/routing"
 	routingdisc "github.com/libp2p/go-libp2p/p2p/discovery/routing"
+	"go.uber.org/fx"
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
 
+	"github.com/celestiaorg/celestia-node/das"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/getters"
@@ -23,6 +26,9 @@ import (
 const (
 	// fullNodesTag is the tag used to identify full nodes in the discovery service.
 	fullNodesTag = "full"
+
+	// samplingStatsTimeout limits the time the pruner waits for DASer sampling stats.
+	samplingStatsTimeout = time.Second * 10
 )
 
 func newDiscovery(cfg *disc.Parameters,
@@ -42,8 +48,23 @@ func newDiscovery(cfg *disc.Parameters,
 	}
 }
 
-func newModule(getter share.Getter, avail share.Availability) Module {
-	return &module{getter, avail}
+type moduleParams struct {
+	fx.In
+
+	Getter share.Getter
+	Avail  share.Availability
+	// light nodes don't keep an EDS store
+	Store   *eds.Store   `optional:"true"`
+	Scanner *eds.Scanner `optional:"true"`
+}
+
+func newModule(params moduleParams) Module {
+	return &module{
+		Getter:       params.Getter,
+		Availability: params.Avail,
+		store:        params.Store,
+		scanner:      params.Scanner,
+	}
 }
 
 // ensureEmptyCARExists adds an empty EDS to the provided EDS store.
@@ -70,6 +91,21 @@ func ensureEmptyEDSInBS(ctx context.Context, bServ blockservice.BlockService) er
 	return err
 }
 
+// dasSafeHeight protects heights that were not yet sampled by the DASer from pruning, so the DASer
+// never has to download the data it already discarded again.
+func dasSafeHeight(daser *das.DASer) eds.SafeHeightFn {
+	return func(ctx context.Context) (uint64, error) {
+		ctx, cancel := context.WithTimeout(ctx, samplingStatsTimeout)
+		defer cancel()
+
+		stats, err := daser.SamplingStats(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return stats.SampledChainHead, nil
+	}
+}
+
 func lightGetter(
 	shrexGetter *getters.ShrexGetter,
 	ipldGetter *getters.IPLDGetter,
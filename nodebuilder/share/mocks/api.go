@@ -8,10 +8,14 @@ import (
 	context "context"
 	reflect "reflect"
 
-	da "github.com/celestiaorg/celestia-app/pkg/da"
-	share "github.com/celestiaorg/celestia-node/share"
-	rsmt2d "github.com/celestiaorg/rsmt2d"
 	gomock "github.com/golang/mock/gomock"
+
+	rsmt2d "github.com/celestiaorg/rsmt2d"
+
+	header "github.com/celestiaorg/celestia-node/header"
+	share "github.com/celestiaorg/celestia-node/share"
+	eds "github.com/celestiaorg/celestia-node/share/eds"
+	cache "github.com/celestiaorg/celestia-node/share/eds/cache"
 )
 
 // MockModule is a mock of Module interface.
@@ -37,8 +41,23 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 	return m.recorder
 }
 
+// CacheStats mocks base method.
+func (m *MockModule) CacheStats(arg0 context.Context) ([]cache.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats", arg0)
+	ret0, _ := ret[0].([]cache.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CacheStats indicates an expected call of CacheStats.
+func (mr *MockModuleMockRecorder) CacheStats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockModule)(nil).CacheStats), arg0)
+}
+
 // GetEDS mocks base method.
-func (m *MockModule) GetEDS(arg0 context.Context, arg1 *da.DataAvailabilityHeader) (*rsmt2d.ExtendedDataSquare, error) {
+func (m *MockModule) GetEDS(arg0 context.Context, arg1 *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEDS", arg0, arg1)
 	ret0, _ := ret[0].(*rsmt2d.ExtendedDataSquare)
@@ -52,8 +71,38 @@ func (mr *MockModuleMockRecorder) GetEDS(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEDS", reflect.TypeOf((*MockModule)(nil).GetEDS), arg0, arg1)
 }
 
+// GetODS mocks base method.
+func (m *MockModule) GetODS(arg0 context.Context, arg1 *header.ExtendedHeader) (<-chan []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetODS", arg0, arg1)
+	ret0, _ := ret[0].(<-chan []byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetODS indicates an expected call of GetODS.
+func (mr *MockModuleMockRecorder) GetODS(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetODS", reflect.TypeOf((*MockModule)(nil).GetODS), arg0, arg1)
+}
+
+// GetRow mocks base method.
+func (m *MockModule) GetRow(arg0 context.Context, arg1 *header.ExtendedHeader, arg2 int, arg3 rsmt2d.Axis) (*share.AxisHalf, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRow", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*share.AxisHalf)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRow indicates an expected call of GetRow.
+func (mr *MockModuleMockRecorder) GetRow(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRow", reflect.TypeOf((*MockModule)(nil).GetRow), arg0, arg1, arg2, arg3)
+}
+
 // GetShare mocks base method.
-func (m *MockModule) GetShare(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2, arg3 int) ([]byte, error) {
+func (m *MockModule) GetShare(arg0 context.Context, arg1 *header.ExtendedHeader, arg2, arg3 int) ([]byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetShare", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]byte)
@@ -68,7 +117,7 @@ func (mr *MockModuleMockRecorder) GetShare(arg0, arg1, arg2, arg3 interface{}) *
 }
 
 // GetSharesByNamespace mocks base method.
-func (m *MockModule) GetSharesByNamespace(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2 share.Namespace) (share.NamespacedShares, error) {
+func (m *MockModule) GetSharesByNamespace(arg0 context.Context, arg1 *header.ExtendedHeader, arg2 share.Namespace) (share.NamespacedShares, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSharesByNamespace", arg0, arg1, arg2)
 	ret0, _ := ret[0].(share.NamespacedShares)
@@ -82,8 +131,23 @@ func (mr *MockModuleMockRecorder) GetSharesByNamespace(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespace", reflect.TypeOf((*MockModule)(nil).GetSharesByNamespace), arg0, arg1, arg2)
 }
 
+// GetSharesByNamespaceRange mocks base method.
+func (m *MockModule) GetSharesByNamespaceRange(arg0 context.Context, arg1 *header.ExtendedHeader, arg2, arg3 share.Namespace) (share.NamespacedShares, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharesByNamespaceRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(share.NamespacedShares)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharesByNamespaceRange indicates an expected call of GetSharesByNamespaceRange.
+func (mr *MockModuleMockRecorder) GetSharesByNamespaceRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespaceRange", reflect.TypeOf((*MockModule)(nil).GetSharesByNamespaceRange), arg0, arg1, arg2, arg3)
+}
+
 // SharesAvailable mocks base method.
-func (m *MockModule) SharesAvailable(arg0 context.Context, arg1 *da.DataAvailabilityHeader) error {
+func (m *MockModule) SharesAvailable(arg0 context.Context, arg1 *header.ExtendedHeader) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SharesAvailable", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -95,3 +159,18 @@ func (mr *MockModuleMockRecorder) SharesAvailable(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharesAvailable", reflect.TypeOf((*MockModule)(nil).SharesAvailable), arg0, arg1)
 }
+
+// StoreHealth mocks base method.
+func (m *MockModule) StoreHealth(arg0 context.Context) (*eds.Health, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreHealth", arg0)
+	ret0, _ := ret[0].(*eds.Health)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreHealth indicates an expected call of StoreHealth.
+func (mr *MockModuleMockRecorder) StoreHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreHealth", reflect.TypeOf((*MockModule)(nil).StoreHealth), arg0)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ipfs/go-datastore"
+	logging "github.com/ipfs/go-log/v2"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/p2p/net/conngater"
 	"go.uber.org/fx"
@@ -11,6 +12,7 @@ import (
 	libhead "github.com/celestiaorg/go-header"
 	"github.com/celestiaorg/go-header/sync"
 
+	"github.com/celestiaorg/celestia-node/das"
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
@@ -23,9 +25,12 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 )
 
+var log = logging.Logger("module/share")
+
 func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option {
 	// sanitize config values before constructing module
 	cfgErr := cfg.Validate(tp)
@@ -62,6 +67,12 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return shrexnd.NewClient(cfg.ShrExNDParams, host)
 			},
 		),
+		fx.Provide(
+			func(host host.Host, network modp2p.Network) (*shrexsample.Client, error) {
+				cfg.ShrExSampleParams.WithNetworkID(network.String())
+				return shrexsample.NewClient(cfg.ShrExSampleParams, host)
+			},
+		),
 		fx.Provide(
 			func(host host.Host, network modp2p.Network) (*shrexeds.Client, error) {
 				cfg.ShrExEDSParams.WithNetworkID(network.String())
@@ -81,7 +92,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 
 	bridgeAndFullComponents := fx.Options(
 		fx.Provide(getters.NewStoreGetter),
-		fx.Invoke(func(edsSrv *shrexeds.Server, ndSrc *shrexnd.Server) {}),
+		fx.Invoke(func(edsSrv *shrexeds.Server, ndSrc *shrexnd.Server, sampleSrv *shrexsample.Server) {}),
 		fx.Provide(fx.Annotate(
 			func(host host.Host, store *eds.Store, network modp2p.Network) (*shrexeds.Server, error) {
 				cfg.ShrExEDSParams.WithNetworkID(network.String())
@@ -98,10 +109,11 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 			func(
 				host host.Host,
 				store *eds.Store,
+				hstore libhead.Store[*header.ExtendedHeader],
 				network modp2p.Network,
 			) (*shrexnd.Server, error) {
 				cfg.ShrExNDParams.WithNetworkID(network.String())
-				return shrexnd.NewServer(cfg.ShrExNDParams, host, store)
+				return shrexnd.NewServer(cfg.ShrExNDParams, host, store, hstore)
 			},
 			fx.OnStart(func(ctx context.Context, server *shrexnd.Server) error {
 				return server.Start(ctx)
@@ -110,6 +122,18 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return server.Stop(ctx)
 			}),
 		)),
+		fx.Provide(fx.Annotate(
+			func(host host.Host, store *eds.Store, network modp2p.Network) (*shrexsample.Server, error) {
+				cfg.ShrExSampleParams.WithNetworkID(network.String())
+				return shrexsample.NewServer(cfg.ShrExSampleParams, host, store)
+			},
+			fx.OnStart(func(ctx context.Context, server *shrexsample.Server) error {
+				return server.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, server *shrexsample.Server) error {
+				return server.Stop(ctx)
+			}),
+		)),
 		fx.Provide(fx.Annotate(
 			func(path node.StorePath, ds datastore.Batching) (*eds.Store, error) {
 				return eds.NewStore(cfg.EDSStoreParams, string(path), ds)
@@ -125,6 +149,35 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return store.Stop(ctx)
 			}),
 		)),
+		fx.Invoke(func(*eds.Pruner) {}),
+		fx.Provide(fx.Annotate(
+			func(
+				store *eds.Store,
+				hstore libhead.Store[*header.ExtendedHeader],
+				ds datastore.Batching,
+				opts []eds.PrunerOption,
+			) (*eds.Pruner, error) {
+				return eds.NewPruner(cfg.EDSStoreParams, store, hstore, ds, opts...)
+			},
+			fx.OnStart(func(ctx context.Context, pruner *eds.Pruner) error {
+				return pruner.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, pruner *eds.Pruner) error {
+				return pruner.Stop(ctx)
+			}),
+		)),
+		fx.Invoke(func(*eds.Scanner) {}),
+		fx.Provide(fx.Annotate(
+			func(store *eds.Store, getter *getters.ShrexGetter) (*eds.Scanner, error) {
+				return eds.NewScanner(cfg.EDSStoreParams, store, getter)
+			},
+			fx.OnStart(func(ctx context.Context, scanner *eds.Scanner) error {
+				return scanner.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, scanner *eds.Scanner) error {
+				return scanner.Stop(ctx)
+			}),
+		)),
 		fx.Provide(fx.Annotate(
 			full.NewShareAvailability,
 			fx.OnStart(func(ctx context.Context, avail *full.ShareAvailability) error {
@@ -170,6 +223,10 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 			"share",
 			baseComponents,
 			fx.Provide(peers.NewManager),
+			// bridge nodes don't sample, so there is nothing to protect from pruning
+			fx.Provide(func() []eds.PrunerOption {
+				return nil
+			}),
 			bridgeAndFullComponents,
 			shrexGetterComponents,
 			fx.Provide(bridgeGetter),
@@ -186,6 +243,11 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 			"share",
 			peerManagerWithShrexPools,
 			baseComponents,
+			fx.Provide(func(daser *das.DASer) []eds.PrunerOption {
+				return []eds.PrunerOption{
+					eds.WithSafeHeight(dasSafeHeight(daser)),
+				}
+			}),
 			bridgeAndFullComponents,
 			shrexGetterComponents,
 			fx.Provide(getters.NewIPLDGetter),
@@ -198,6 +260,8 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 			fx.Provide(func() []light.Option {
 				return []light.Option{
 					light.WithSampleAmount(cfg.LightAvailability.SampleAmount),
+					light.WithConfidence(cfg.LightAvailability.Confidence),
+					light.WithMaxResamples(cfg.LightAvailability.MaxResamples),
 				}
 			}),
 			peerManagerWithShrexPools,
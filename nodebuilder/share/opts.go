@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 )
 
 // WithPeerManagerMetrics is a utility function to turn on peer manager metrics and that is
@@ -21,22 +22,40 @@ func WithDiscoveryMetrics(d *disc.Discovery) error {
 	return d.WithMetrics()
 }
 
-func WithShrexClientMetrics(edsClient *shrexeds.Client, ndClient *shrexnd.Client) error {
+func WithShrexClientMetrics(
+	edsClient *shrexeds.Client,
+	ndClient *shrexnd.Client,
+	sampleClient *shrexsample.Client,
+) error {
 	err := edsClient.WithMetrics()
 	if err != nil {
 		return err
 	}
 
-	return ndClient.WithMetrics()
+	err = ndClient.WithMetrics()
+	if err != nil {
+		return err
+	}
+
+	return sampleClient.WithMetrics()
 }
 
-func WithShrexServerMetrics(edsServer *shrexeds.Server, ndServer *shrexnd.Server) error {
+func WithShrexServerMetrics(
+	edsServer *shrexeds.Server,
+	ndServer *shrexnd.Server,
+	sampleServer *shrexsample.Server,
+) error {
 	err := edsServer.WithMetrics()
 	if err != nil {
 		return err
 	}
 
-	return ndServer.WithMetrics()
+	err = ndServer.WithMetrics()
+	if err != nil {
+		return err
+	}
+
+	return sampleServer.WithMetrics()
 }
 
 func WithShrexGetterMetrics(sg *getters.ShrexGetter) error {
@@ -46,3 +65,11 @@ func WithShrexGetterMetrics(sg *getters.ShrexGetter) error {
 func WithStoreMetrics(s *eds.Store) error {
 	return s.WithMetrics()
 }
+
+func WithPrunerMetrics(p *eds.Pruner) error {
+	return p.WithMetrics()
+}
+
+func WithScannerMetrics(s *eds.Scanner) error {
+	return s.WithMetrics()
+}
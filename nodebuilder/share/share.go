@@ -1,16 +1,23 @@
 package share
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 
 	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/cache"
 )
 
 var _ Module = (*API)(nil)
 
+var errNoStore = errors.New("share: light nodes don't keep an EDS store")
+
 // Module provides access to any data square or block share on the network.
 //
 // All Get methods provided on Module follow the following flow:
@@ -33,13 +40,34 @@ type Module interface {
 	SharesAvailable(context.Context, *header.ExtendedHeader) error
 	// GetShare gets a Share by coordinates in EDS.
 	GetShare(ctx context.Context, header *header.ExtendedHeader, row, col int) (share.Share, error)
+	// GetRow gets the first half of the row or column with the given index of the EDS along with
+	// its inclusion proof against the root of the axis. The whole row or column can be recovered
+	// by extending the half.
+	GetRow(ctx context.Context, header *header.ExtendedHeader, idx int, axis rsmt2d.Axis) (*share.AxisHalf, error)
 	// GetEDS gets the full EDS identified by the given extended header.
 	GetEDS(ctx context.Context, header *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error)
+	// GetODS streams the ODS of the EDS identified by the given extended header in the CARv1 layout
+	// of the EDS store. The first message holds the CAR header and each of the following ones holds
+	// a row of the ODS, so that rows can be verified as they arrive with eds.ODSRowReader.
+	GetODS(ctx context.Context, header *header.ExtendedHeader) (<-chan []byte, error)
 	// GetSharesByNamespace gets all shares from an EDS within the given namespace.
 	// Shares are returned in a row-by-row order if the namespace spans multiple rows.
 	GetSharesByNamespace(
 		ctx context.Context, header *header.ExtendedHeader, namespace share.Namespace,
 	) (share.NamespacedShares, error)
+	// GetSharesByNamespaceRange gets all shares from an EDS within any of the namespaces between min
+	// and max, inclusive.
+	// Shares are returned in a row-by-row order if the namespaces span multiple rows.
+	GetSharesByNamespaceRange(
+		ctx context.Context, header *header.ExtendedHeader, min, max share.Namespace,
+	) (share.NamespacedShares, error)
+	// StoreHealth reports the results of the integrity scans of the local EDS store. It is only
+	// available on bridge and full nodes, which only scan the store once IntegrityScanInterval is
+	// set in the EDS store parameters.
+	StoreHealth(ctx context.Context) (*eds.Health, error)
+	// CacheStats reports the contents and the hit ratios of the accessor caches of the local EDS
+	// store. It is only available on bridge and full nodes.
+	CacheStats(ctx context.Context) ([]cache.Stats, error)
 }
 
 // API is a wrapper around Module for the RPC.
@@ -52,15 +80,32 @@ type API struct {
 			header *header.ExtendedHeader,
 			row, col int,
 		) (share.Share, error) `perm:"read"`
+		GetRow func(
+			ctx context.Context,
+			header *header.ExtendedHeader,
+			idx int,
+			axis rsmt2d.Axis,
+		) (*share.AxisHalf, error) `perm:"read"`
 		GetEDS func(
 			ctx context.Context,
 			header *header.ExtendedHeader,
 		) (*rsmt2d.ExtendedDataSquare, error) `perm:"read"`
+		GetODS func(
+			ctx context.Context,
+			header *header.ExtendedHeader,
+		) (<-chan []byte, error) `perm:"read"`
 		GetSharesByNamespace func(
 			ctx context.Context,
 			header *header.ExtendedHeader,
 			namespace share.Namespace,
 		) (share.NamespacedShares, error) `perm:"read"`
+		GetSharesByNamespaceRange func(
+			ctx context.Context,
+			header *header.ExtendedHeader,
+			min, max share.Namespace,
+		) (share.NamespacedShares, error) `perm:"read"`
+		StoreHealth func(ctx context.Context) (*eds.Health, error)   `perm:"read"`
+		CacheStats  func(ctx context.Context) ([]cache.Stats, error) `perm:"admin"`
 	}
 }
 
@@ -72,10 +117,23 @@ func (api *API) GetShare(ctx context.Context, header *header.ExtendedHeader, row
 	return api.Internal.GetShare(ctx, header, row, col)
 }
 
+func (api *API) GetRow(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisHalf, error) {
+	return api.Internal.GetRow(ctx, header, idx, axis)
+}
+
 func (api *API) GetEDS(ctx context.Context, header *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error) {
 	return api.Internal.GetEDS(ctx, header)
 }
 
+func (api *API) GetODS(ctx context.Context, header *header.ExtendedHeader) (<-chan []byte, error) {
+	return api.Internal.GetODS(ctx, header)
+}
+
 func (api *API) GetSharesByNamespace(
 	ctx context.Context,
 	header *header.ExtendedHeader,
@@ -84,11 +142,101 @@ func (api *API) GetSharesByNamespace(
 	return api.Internal.GetSharesByNamespace(ctx, header, namespace)
 }
 
+func (api *API) GetSharesByNamespaceRange(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	min, max share.Namespace,
+) (share.NamespacedShares, error) {
+	return api.Internal.GetSharesByNamespaceRange(ctx, header, min, max)
+}
+
+func (api *API) StoreHealth(ctx context.Context) (*eds.Health, error) {
+	return api.Internal.StoreHealth(ctx)
+}
+
+func (api *API) CacheStats(ctx context.Context) ([]cache.Stats, error) {
+	return api.Internal.CacheStats(ctx)
+}
+
 type module struct {
 	share.Getter
 	share.Availability
+
+	store   *eds.Store
+	scanner *eds.Scanner
 }
 
 func (m module) SharesAvailable(ctx context.Context, header *header.ExtendedHeader) error {
 	return m.Availability.SharesAvailable(ctx, header)
 }
+
+func (m module) GetODS(ctx context.Context, header *header.ExtendedHeader) (<-chan []byte, error) {
+	// stream the stored square as is, instead of loading and re-encoding it
+	if m.store != nil {
+		r, err := m.store.GetCAR(ctx, header.DAH.Hash())
+		switch {
+		case err == nil:
+			return m.streamODS(ctx, header, func(w io.Writer) error {
+				defer r.Close()
+				return eds.CopyODS(w, r)
+			}), nil
+		case !errors.Is(err, eds.ErrNotFound):
+			return nil, err
+		}
+	}
+
+	square, err := m.GetEDS(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	return m.streamODS(ctx, header, func(w io.Writer) error {
+		return eds.WriteODS(square, w)
+	}), nil
+}
+
+// streamODS sends the ODS written by the given function to the returned channel and closes it once
+// the ODS is written.
+func (m module) streamODS(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	write func(io.Writer) error,
+) <-chan []byte {
+	odsCh := make(chan []byte)
+	go func() {
+		defer close(odsCh)
+		err := write(&chanWriter{ctx: ctx, ch: odsCh})
+		if err != nil && ctx.Err() == nil {
+			log.Errorw("streaming ODS", "height", header.Height(), "err", err)
+		}
+	}()
+	return odsCh
+}
+
+func (m module) StoreHealth(context.Context) (*eds.Health, error) {
+	if m.scanner == nil {
+		return nil, errNoStore
+	}
+	return m.scanner.Health(), nil
+}
+
+func (m module) CacheStats(context.Context) ([]cache.Stats, error) {
+	if m.store == nil {
+		return nil, errNoStore
+	}
+	return m.store.CacheStats(), nil
+}
+
+// chanWriter sends every written chunk to the channel.
+type chanWriter struct {
+	ctx context.Context
+	ch  chan<- []byte
+}
+
+func (w *chanWriter) Write(p []byte) (int, error) {
+	select {
+	case w.ch <- bytes.Clone(p):
+		return len(p), nil
+	case <-w.ctx.Done():
+		return 0, w.ctx.Err()
+	}
+}
@@ -1,6 +1,7 @@
 package share
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -9,8 +10,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 )
 
 func Test_EmptyCARExists(t *testing.T) {
@@ -41,3 +44,42 @@ func Test_EmptyCARExists(t *testing.T) {
 	assert.Equal(t, eds.Flattened(), emptyEds.Flattened())
 	assert.NoError(t, err)
 }
+
+func TestGetODS_FromStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	edsStore, err := eds.NewStore(eds.DefaultParameters(), t.TempDir(), ds)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	square := edstest.RandEDS(t, 8)
+	eh := headertest.ExtendedHeaderFromEDS(t, 1, square)
+	err = edsStore.Put(ctx, eh.DAH.Hash(), square)
+	require.NoError(t, err)
+
+	var expected [][]byte
+	err = eds.WriteODS(square, writerFunc(func(p []byte) (int, error) {
+		expected = append(expected, bytes.Clone(p))
+		return len(p), nil
+	}))
+	require.NoError(t, err)
+
+	// the square is streamed from the store without the getter
+	m := module{store: edsStore}
+	odsCh, err := m.GetODS(ctx, eh)
+	require.NoError(t, err)
+	var chunks [][]byte
+	for chunk := range odsCh {
+		chunks = append(chunks, chunk)
+	}
+	assert.Equal(t, expected, chunks)
+}
+
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
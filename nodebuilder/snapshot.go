@@ -0,0 +1,364 @@
+package nodebuilder
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	libhead "github.com/celestiaorg/go-header"
+	headerstore "github.com/celestiaorg/go-header/store"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+)
+
+// ErrSnapshotGap is thrown on attempt to restore a snapshot which doesn't continue the headers
+// of the Node Store.
+var ErrSnapshotGap = errors.New("node: snapshot doesn't continue the stored headers")
+
+// ErrSnapshotUntrusted is thrown on attempt to initialize an empty Node Store with a snapshot
+// whose first header is not the trusted one.
+var ErrSnapshotUntrusted = errors.New("node: first header of the snapshot is not trusted")
+
+// a snapshot is a tar archive with the header and the CAR encoded ODS of each height in the
+// range, named after the height with the following extensions.
+const (
+	snapshotHeaderExt = ".header"
+	snapshotODSExt    = ".car"
+)
+
+// CreateSnapshot writes the headers of the Node Store under 'path' with the heights in range
+// ['from':'to'] along with the ODSes of their EDSes into the 'w' as a snapshot archive.
+// Zero 'to' stands for the head of the stored headers.
+// The Node must be stopped, as the Store is opened for exclusive access.
+func CreateSnapshot(ctx context.Context, path string, tp node.Type, w io.Writer, from, to uint64) (err error) {
+	ss, err := openSnapshotStores(ctx, path, tp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, ss.close(ctx))
+	}()
+
+	head, err := ss.headers.Head(ctx)
+	if err != nil {
+		return fmt.Errorf("node: getting head of the stored headers: %w", err)
+	}
+	if to == 0 || to > head.Height() {
+		to = head.Height()
+	}
+	if from == 0 || from > to {
+		return fmt.Errorf("node: invalid snapshot range from %d to %d", from, to)
+	}
+	log.Infow("Creating snapshot", "from", from, "to", to)
+
+	tw := tar.NewWriter(w)
+	for height := from; height <= to; height++ {
+		h, err := ss.headers.GetByHeight(ctx, height)
+		if err != nil {
+			return fmt.Errorf("node: getting header %d: %w", height, err)
+		}
+
+		err = writeSnapshotHeader(tw, h)
+		if err != nil {
+			return fmt.Errorf("node: writing header %d: %w", height, err)
+		}
+
+		err = ss.writeODS(ctx, tw, h)
+		if err != nil {
+			return fmt.Errorf("node: writing ODS of height %d: %w", height, err)
+		}
+	}
+
+	log.Infow("Snapshot created", "from", from, "to", to)
+	return tw.Close()
+}
+
+// RestoreSnapshot reads the snapshot archive from the 'r' into the initialized Node Store under
+// 'path'. Each header of the snapshot is validated and appended to the stored ones, which they
+// have to continue. If there are none, the first header of the snapshot must have the
+// 'trustedHash' or, if it's empty, the TrustedHash of the Node config. Each ODS is verified against
+// the data hash of its header before being put into the eds.Store, which also indexes it.
+// The Node must be stopped, as the Store is opened for exclusive access.
+func RestoreSnapshot(
+	ctx context.Context,
+	path string,
+	tp node.Type,
+	r io.Reader,
+	trustedHash libhead.Hash,
+) (err error) {
+	ss, err := openSnapshotStores(ctx, path, tp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, ss.close(ctx))
+	}()
+
+	head, err := ss.headers.Head(ctx)
+	if err != nil && !errors.Is(err, libhead.ErrNoHead) {
+		return fmt.Errorf("node: getting head of the stored headers: %w", err)
+	}
+	// an empty Store is initialized with the first header of the snapshot
+	empty := head == nil
+	if empty && len(trustedHash) == 0 {
+		cfg, err := ss.store.Config()
+		if err != nil {
+			return err
+		}
+		trustedHash, err = hex.DecodeString(cfg.Header.TrustedHash)
+		if err != nil {
+			return fmt.Errorf("node: parsing trusted hash: %w", err)
+		}
+	}
+
+	var first, restored uint64
+	tr := tar.NewReader(r)
+	for {
+		h, err := readSnapshotHeader(tr)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("node: reading header: %w", err)
+		}
+
+		err = ss.restoreHeader(ctx, head, h, trustedHash)
+		if err != nil {
+			return fmt.Errorf("node: restoring header %d: %w", h.Height(), err)
+		}
+		if head == nil || h.Height() > head.Height() {
+			head = h
+		}
+		if first == 0 {
+			first = h.Height()
+		}
+
+		err = ss.restoreODS(ctx, tr, h)
+		if err != nil {
+			return fmt.Errorf("node: restoring ODS of height %d: %w", h.Height(), err)
+		}
+		restored++
+	}
+	if restored == 0 {
+		return fmt.Errorf("node: empty snapshot")
+	}
+
+	// there are no headers to sample below the first one of the snapshot
+	if empty && tp != node.Bridge {
+		cfg, err := ss.store.Config()
+		if err != nil {
+			return err
+		}
+		if cfg.DASer.SampleFrom < first {
+			cfg.DASer.SampleFrom = first
+			err = ss.store.PutConfig(cfg)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Infow("Snapshot restored", "from", first, "to", head.Height(), "heights", restored)
+	return nil
+}
+
+// snapshotStores gives access to the stores of the Node snapshots are made of.
+type snapshotStores struct {
+	store   Store
+	headers *headerstore.Store[*header.ExtendedHeader]
+	eds     *eds.Store
+}
+
+func openSnapshotStores(ctx context.Context, path string, tp node.Type) (_ *snapshotStores, err error) {
+	if tp == node.Light {
+		return nil, fmt.Errorf("node: light nodes don't store EDSes to snapshot")
+	}
+
+	s, err := OpenStore(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, s.Close())
+		}
+	}()
+
+	cfg, err := s.Config()
+	if err != nil {
+		return nil, err
+	}
+	ds, err := s.Datastore()
+	if err != nil {
+		return nil, err
+	}
+
+	hstore, err := headerstore.NewStore[*header.ExtendedHeader](ds, headerstore.WithParams(cfg.Header.Store))
+	if err != nil {
+		return nil, err
+	}
+	err = hstore.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	edsStore, err := eds.NewStore(cfg.Share.EDSStoreParams, s.Path(), ds)
+	if err == nil {
+		err = edsStore.Start(ctx)
+	}
+	if err != nil {
+		return nil, errors.Join(err, hstore.Stop(ctx))
+	}
+
+	return &snapshotStores{
+		store:   s,
+		headers: hstore,
+		eds:     edsStore,
+	}, nil
+}
+
+func (ss *snapshotStores) close(ctx context.Context) error {
+	return errors.Join(ss.eds.Stop(ctx), ss.headers.Stop(ctx), ss.store.Close())
+}
+
+func writeSnapshotHeader(tw *tar.Writer, h *header.ExtendedHeader) error {
+	bin, err := h.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	err = tw.WriteHeader(snapshotEntry(h, snapshotHeaderExt, int64(len(bin))))
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(bin)
+	return err
+}
+
+func (ss *snapshotStores) writeODS(ctx context.Context, tw *tar.Writer, h *header.ExtendedHeader) error {
+	size, err := eds.ODSSize(h.DAH)
+	if err != nil {
+		return err
+	}
+
+	r, err := ss.eds.GetCAR(ctx, h.DAH.Hash())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	odsR, err := eds.ODSReader(r)
+	if err != nil {
+		return err
+	}
+
+	err = tw.WriteHeader(snapshotEntry(h, snapshotODSExt, size))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, odsR)
+	return err
+}
+
+func readSnapshotHeader(tr *tar.Reader) (*header.ExtendedHeader, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	bin, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	h := new(header.ExtendedHeader)
+	err = h.UnmarshalBinary(bin)
+	if err != nil {
+		return nil, err
+	}
+	if hdr.Name != snapshotEntryName(h, snapshotHeaderExt) {
+		return nil, fmt.Errorf("unexpected snapshot entry %s", hdr.Name)
+	}
+	return h, nil
+}
+
+// restoreHeader validates the header and puts it into the header store if it is the next one after
+// the head or the trusted one for the empty store, otherwise ensures the same header is stored
+// already.
+func (ss *snapshotStores) restoreHeader(
+	ctx context.Context,
+	head, h *header.ExtendedHeader,
+	trustedHash libhead.Hash,
+) error {
+	if err := h.Validate(); err != nil {
+		return err
+	}
+
+	switch {
+	case head == nil:
+		if !bytes.Equal(h.Hash(), trustedHash) {
+			return fmt.Errorf("%w: header %s, trusted %s", ErrSnapshotUntrusted, h.Hash(), trustedHash)
+		}
+		return ss.headers.Init(ctx, h)
+	case h.Height() == head.Height()+1:
+		return ss.headers.Append(ctx, h)
+	case h.Height() > head.Height():
+		return fmt.Errorf("%w: stored head %d", ErrSnapshotGap, head.Height())
+	}
+
+	stored, err := ss.headers.GetByHeight(ctx, h.Height())
+	if err != nil {
+		return err
+	}
+	if !stored.Equals(h) {
+		return fmt.Errorf("stored header %s differs from the snapshot one %s", stored.Hash(), h.Hash())
+	}
+	return nil
+}
+
+// restoreODS puts the EDS of the header recomputed from the next ODS of the snapshot into the
+// eds.Store, unless it is stored already.
+func (ss *snapshotStores) restoreODS(ctx context.Context, tr *tar.Reader, h *header.ExtendedHeader) error {
+	hdr, err := tr.Next()
+	if err != nil {
+		return err
+	}
+	if hdr.Name != snapshotEntryName(h, snapshotODSExt) {
+		return fmt.Errorf("unexpected snapshot entry %s", hdr.Name)
+	}
+
+	// the data hash is committed to by the validators, unlike the DAH carried along
+	has, err := ss.eds.Has(ctx, share.DataHash(h.DataHash))
+	if err != nil || has {
+		return err
+	}
+
+	// ReadEDS recomputes the EDS and ensures its root matches the data hash
+	square, err := eds.ReadEDS(ctx, tr, share.DataHash(h.DataHash))
+	if err != nil {
+		return err
+	}
+	return ss.eds.Put(ctx, share.DataHash(h.DataHash), square)
+}
+
+func snapshotEntry(h *header.ExtendedHeader, ext string, size int64) *tar.Header {
+	return &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     snapshotEntryName(h, ext),
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  h.Time(),
+	}
+}
+
+func snapshotEntryName(h *header.ExtendedHeader, ext string) string {
+	return strconv.FormatUint(h.Height(), 10) + ext
+}
@@ -0,0 +1,189 @@
+package nodebuilder
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+)
+
+func TestSnapshot(t *testing.T) {
+	const heights = 6
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	// fill the store of the source node with the headers and their EDSes
+	src := t.TempDir()
+	err := Init(*DefaultConfig(node.Full), src, node.Full)
+	require.NoError(t, err)
+
+	ss, err := openSnapshotStores(ctx, src, node.Full)
+	require.NoError(t, err)
+
+	suite := headertest.NewTestSuite(t, 3)
+	headers := []*header.ExtendedHeader{suite.Head()}
+	err = ss.headers.Init(ctx, headers[0])
+	require.NoError(t, err)
+	err = ss.eds.Put(ctx, headers[0].DAH.Hash(), share.EmptyExtendedDataSquare())
+	require.NoError(t, err)
+	for len(headers) < heights {
+		square := edstest.RandEDS(t, 4)
+		h := nextHeaderWithEDS(t, suite, headers[len(headers)-1], square)
+		err = ss.headers.Append(ctx, h)
+		require.NoError(t, err)
+		err = ss.eds.Put(ctx, h.DAH.Hash(), square)
+		require.NoError(t, err)
+		headers = append(headers, h)
+	}
+	require.NoError(t, ss.close(ctx))
+
+	first, second := new(bytes.Buffer), new(bytes.Buffer)
+	err = CreateSnapshot(ctx, src, node.Full, first, 2, heights/2)
+	require.NoError(t, err)
+	err = CreateSnapshot(ctx, src, node.Full, second, heights/2+2, 0)
+	require.NoError(t, err)
+
+	// restore the snapshots into the store of a new node
+	dst := t.TempDir()
+	err = Init(*DefaultConfig(node.Full), dst, node.Full)
+	require.NoError(t, err)
+
+	snapshot := first.Bytes()
+	// the first header of the snapshot initializes the empty store only if it's trusted
+	err = RestoreSnapshot(ctx, dst, node.Full, bytes.NewReader(snapshot), headers[2].Hash())
+	require.ErrorIs(t, err, ErrSnapshotUntrusted)
+	err = RestoreSnapshot(ctx, dst, node.Full, bytes.NewReader(snapshot), headers[1].Hash())
+	require.NoError(t, err)
+	// restoring the same snapshot again is a noop
+	err = RestoreSnapshot(ctx, dst, node.Full, bytes.NewReader(snapshot), nil)
+	require.NoError(t, err)
+	// the second snapshot misses a height after the stored head
+	err = RestoreSnapshot(ctx, dst, node.Full, second, nil)
+	require.ErrorIs(t, err, ErrSnapshotGap)
+
+	second.Reset()
+	err = CreateSnapshot(ctx, src, node.Full, second, heights/2, 0)
+	require.NoError(t, err)
+	err = RestoreSnapshot(ctx, dst, node.Full, second, nil)
+	require.NoError(t, err)
+
+	ss, err = openSnapshotStores(ctx, dst, node.Full)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, ss.close(ctx))
+	})
+
+	head, err := ss.headers.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, heights, head.Height())
+	_, err = ss.headers.GetByHeight(ctx, 1)
+	require.Error(t, err)
+	for _, h := range headers[1:] {
+		stored, err := ss.headers.GetByHeight(ctx, h.Height())
+		require.NoError(t, err)
+		require.True(t, stored.Equals(h))
+
+		square, err := ss.eds.Get(ctx, h.DAH.Hash())
+		require.NoError(t, err)
+		dah, err := share.NewRoot(square)
+		require.NoError(t, err)
+		require.True(t, dah.Equals(h.DAH))
+	}
+
+	// empty store gets its sampling started from the first restored height
+	cfg, err := ss.store.Config()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, cfg.DASer.SampleFrom)
+}
+
+// TestSnapshot_TamperedDAH ensures that a snapshot pairing a valid header with another DAH and its
+// ODS is rejected.
+func TestSnapshot_TamperedDAH(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	suite := headertest.NewTestSuite(t, 3)
+	trusted := suite.Head()
+	h := nextHeaderWithEDS(t, suite, trusted, edstest.RandEDS(t, 4))
+
+	// the tampered header keeps the data hash signed by the validators
+	fake := edstest.RandEDS(t, 4)
+	tampered := *h
+	var err error
+	tampered.DAH, err = share.NewRoot(fake)
+	require.NoError(t, err)
+
+	snapshot := new(bytes.Buffer)
+	tw := tar.NewWriter(snapshot)
+	require.NoError(t, writeSnapshotHeader(tw, trusted))
+	writeSnapshotODS(t, tw, trusted, share.EmptyExtendedDataSquare())
+	require.NoError(t, writeSnapshotHeader(tw, &tampered))
+	writeSnapshotODS(t, tw, &tampered, fake)
+	require.NoError(t, tw.Close())
+
+	dst := t.TempDir()
+	err = Init(*DefaultConfig(node.Full), dst, node.Full)
+	require.NoError(t, err)
+	err = RestoreSnapshot(ctx, dst, node.Full, snapshot, trusted.Hash())
+	require.ErrorContains(t, err, "mismatch between data hash")
+
+	ss, err := openSnapshotStores(ctx, dst, node.Full)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, ss.close(ctx))
+	})
+	head, err := ss.headers.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, trusted.Height(), head.Height())
+	has, err := ss.eds.Has(ctx, tampered.DAH.Hash())
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+// writeSnapshotODS writes the ODS of the square into the snapshot as the one of the header.
+func writeSnapshotODS(
+	t *testing.T,
+	tw *tar.Writer,
+	h *header.ExtendedHeader,
+	square *rsmt2d.ExtendedDataSquare,
+) {
+	size, err := eds.ODSSize(h.DAH)
+	require.NoError(t, err)
+	require.NoError(t, tw.WriteHeader(snapshotEntry(h, snapshotODSExt, size)))
+	require.NoError(t, eds.WriteODS(square, tw))
+}
+
+// nextHeaderWithEDS makes a valid header following the given one with the data root of the EDS.
+func nextHeaderWithEDS(
+	t *testing.T,
+	suite *headertest.TestSuite,
+	prev *header.ExtendedHeader,
+	square *rsmt2d.ExtendedDataSquare,
+) *header.ExtendedHeader {
+	dah, err := share.NewRoot(square)
+	require.NoError(t, err)
+
+	rh := suite.GenRawHeader(prev.Height()+1, prev.Hash(), libhead.Hash(prev.Commit.Hash()), dah.Hash())
+	h := &header.ExtendedHeader{
+		RawHeader:    *rh,
+		Commit:       suite.Commit(rh),
+		ValidatorSet: prev.ValidatorSet,
+		DAH:          dah,
+	}
+	require.NoError(t, h.Validate())
+	return h
+}
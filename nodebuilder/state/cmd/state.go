@@ -1,24 +1,34 @@
 package cmd
 
 import (
+	"bufio"
 	"encoding/hex"
 	"fmt"
 	"strconv"
 
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/spf13/cobra"
 
+	"github.com/celestiaorg/celestia-node/blob"
 	cmdnode "github.com/celestiaorg/celestia-node/cmd"
 	"github.com/celestiaorg/celestia-node/state"
 )
 
+// account is the key name or the address of the keyring account that signs transactions.
+var account string
+
 func init() {
 	Cmd.AddCommand(
 		accountAddressCmd,
+		listAccountsCmd,
+		addAccountCmd,
+		importAccountCmd,
 		balanceCmd,
 		balanceForAddressCmd,
 		transferCmd,
 		submitTxCmd,
+		estimateFeeCmd,
 		cancelUnbondingDelegationCmd,
 		beginRedelegateCmd,
 		undelegateCmd,
@@ -27,6 +37,22 @@ func init() {
 		queryUnbondingCmd,
 		queryRedelegationCmd,
 	)
+
+	for _, cmd := range []*cobra.Command{
+		transferCmd,
+		cancelUnbondingDelegationCmd,
+		beginRedelegateCmd,
+		undelegateCmd,
+		delegateCmd,
+	} {
+		cmd.PersistentFlags().StringVar(
+			&account,
+			"account",
+			"",
+			"specifies the key name or the address of the node's account that signs the transaction. "+
+				"The default account is used if it is not set.",
+		)
+	}
 }
 
 var Cmd = &cobra.Command{
@@ -52,6 +78,60 @@ var accountAddressCmd = &cobra.Command{
 	},
 }
 
+var listAccountsCmd = &cobra.Command{
+	Use:   "list-accounts",
+	Short: "Lists all the accounts of the node's keyring.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		accounts, err := client.State.ListAccounts(cmd.Context())
+		return cmdnode.PrintOutput(accounts, err, nil)
+	},
+}
+
+var addAccountCmd = &cobra.Command{
+	Use:   "add-account [name]",
+	Short: "Generates a new account with the given key name in the node's keyring.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		acc, err := client.State.AddAccount(cmd.Context(), args[0])
+		return cmdnode.PrintOutput(acc, err, nil)
+	},
+}
+
+var importAccountCmd = &cobra.Command{
+	Use: "import-account [name]",
+	Short: "Imports the account with the given key name to the node's keyring from the mnemonic " +
+		"read from the standard input.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mnemonic, err := input.GetString("Enter your bip39 mnemonic", bufio.NewReader(cmd.InOrStdin()))
+		if err != nil {
+			return fmt.Errorf("error reading the mnemonic: %w", err)
+		}
+
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		acc, err := client.State.ImportAccount(cmd.Context(), args[0], mnemonic)
+		return cmdnode.PrintOutput(acc, err, nil)
+	},
+}
+
 var balanceCmd = &cobra.Command{
 	Use: "balance",
 	Short: "Retrieves the Celestia coin balance for the node's account/signer and verifies it against " +
@@ -120,11 +200,12 @@ var transferCmd = &cobra.Command{
 			return fmt.Errorf("error parsing a gas limit:%v", err)
 		}
 
-		txResponse, err := client.State.Transfer(
+		txResponse, err := client.State.TransferWithAccount(
 			cmd.Context(),
 			addr.Address.(state.AccAddress),
 			math.NewInt(amount),
 			math.NewInt(fee), gasLimit,
+			account,
 		)
 		return cmdnode.PrintOutput(txResponse, err, nil)
 	},
@@ -153,6 +234,32 @@ var submitTxCmd = &cobra.Command{
 	},
 }
 
+var estimateFeeCmd = &cobra.Command{
+	Use:   "estimate-fee [namespace] [blobData]",
+	Short: "Estimates the gas limit, the minimum gas price and the fee of submitting the blob at the given namespace.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdnode.ParseClientFromCtx(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		namespace, err := cmdnode.ParseV0Namespace(args[0])
+		if err != nil {
+			return fmt.Errorf("error parsing a namespace:%v", err)
+		}
+
+		parsedBlob, err := blob.NewBlobV0(namespace, []byte(args[1]))
+		if err != nil {
+			return fmt.Errorf("error creating a blob:%v", err)
+		}
+
+		estimate, err := client.State.EstimateFee(cmd.Context(), []*blob.Blob{parsedBlob})
+		return cmdnode.PrintOutput(estimate, err, nil)
+	},
+}
+
 var cancelUnbondingDelegationCmd = &cobra.Command{
 	Use:   "cancel-unbonding-delegation [address] [amount] [height] [fee] [gasLimit]",
 	Short: "Cancels a user's pending undelegation from a validator.",
@@ -189,13 +296,14 @@ var cancelUnbondingDelegationCmd = &cobra.Command{
 			return fmt.Errorf("error parsing a gas limit:%v", err)
 		}
 
-		txResponse, err := client.State.CancelUnbondingDelegation(
+		txResponse, err := client.State.CancelUnbondingDelegationWithAccount(
 			cmd.Context(),
 			addr.Address.(state.ValAddress),
 			math.NewInt(amount),
 			math.NewInt(height),
 			math.NewInt(fee),
 			gasLimit,
+			account,
 		)
 		return cmdnode.PrintOutput(txResponse, err, nil)
 	},
@@ -236,13 +344,14 @@ var beginRedelegateCmd = &cobra.Command{
 			return fmt.Errorf("error parsing a gas limit:%v", err)
 		}
 
-		txResponse, err := client.State.BeginRedelegate(
+		txResponse, err := client.State.BeginRedelegateWithAccount(
 			cmd.Context(),
 			srcAddr.Address.(state.ValAddress),
 			dstAddr.Address.(state.ValAddress),
 			math.NewInt(amount),
 			math.NewInt(fee),
 			gasLimit,
+			account,
 		)
 		return cmdnode.PrintOutput(txResponse, err, nil)
 	},
@@ -277,12 +386,13 @@ var undelegateCmd = &cobra.Command{
 			return fmt.Errorf("error parsing a gas limit:%v", err)
 		}
 
-		txResponse, err := client.State.Undelegate(
+		txResponse, err := client.State.UndelegateWithAccount(
 			cmd.Context(),
 			addr.Address.(state.ValAddress),
 			math.NewInt(amount),
 			math.NewInt(fee),
 			gasLimit,
+			account,
 		)
 		return cmdnode.PrintOutput(txResponse, err, nil)
 	},
@@ -319,12 +429,13 @@ var delegateCmd = &cobra.Command{
 			return fmt.Errorf("error parsing a gas limit:%v", err)
 		}
 
-		txResponse, err := client.State.Delegate(
+		txResponse, err := client.State.DelegateWithAccount(
 			cmd.Context(),
 			addr.Address.(state.ValAddress),
 			math.NewInt(amount),
 			math.NewInt(fee),
 			gasLimit,
+			account,
 		)
 		return cmdnode.PrintOutput(txResponse, err, nil)
 	},
@@ -1,6 +1,11 @@
 package state
 
-import "github.com/cosmos/cosmos-sdk/crypto/keyring"
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
 
 var defaultKeyringBackend = keyring.BackendTest
 
@@ -9,6 +14,9 @@ var defaultKeyringBackend = keyring.BackendTest
 type Config struct {
 	KeyringAccName string
 	KeyringBackend string
+	// TxCommitTimeout bounds the time to wait for a submitted transaction to be committed. The wait
+	// is bound only by the context of the request if it is zero.
+	TxCommitTimeout time.Duration
 }
 
 func DefaultConfig() Config {
@@ -20,5 +28,8 @@ func DefaultConfig() Config {
 
 // Validate performs basic validation of the config.
 func (cfg *Config) Validate() error {
+	if cfg.TxCommitTimeout < 0 {
+		return fmt.Errorf("invalid tx commit timeout: %v", cfg.TxCommitTimeout)
+	}
 	return nil
 }
@@ -56,6 +56,21 @@ func (mr *MockModuleMockRecorder) AccountAddress(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountAddress", reflect.TypeOf((*MockModule)(nil).AccountAddress), arg0)
 }
 
+// AddAccount mocks base method.
+func (m *MockModule) AddAccount(arg0 context.Context, arg1 string) (*state.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccount", arg0, arg1)
+	ret0, _ := ret[0].(*state.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccount indicates an expected call of AddAccount.
+func (mr *MockModuleMockRecorder) AddAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccount", reflect.TypeOf((*MockModule)(nil).AddAccount), arg0, arg1)
+}
+
 // Balance mocks base method.
 func (m *MockModule) Balance(arg0 context.Context) (*types.Coin, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +116,21 @@ func (mr *MockModuleMockRecorder) BeginRedelegate(arg0, arg1, arg2, arg3, arg4,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRedelegate", reflect.TypeOf((*MockModule)(nil).BeginRedelegate), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// BeginRedelegateWithAccount mocks base method.
+func (m *MockModule) BeginRedelegateWithAccount(arg0 context.Context, arg1, arg2 types.ValAddress, arg3, arg4 math.Int, arg5 uint64, arg6 string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginRedelegateWithAccount", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginRedelegateWithAccount indicates an expected call of BeginRedelegateWithAccount.
+func (mr *MockModuleMockRecorder) BeginRedelegateWithAccount(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRedelegateWithAccount", reflect.TypeOf((*MockModule)(nil).BeginRedelegateWithAccount), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // CancelUnbondingDelegation mocks base method.
 func (m *MockModule) CancelUnbondingDelegation(arg0 context.Context, arg1 types.ValAddress, arg2, arg3, arg4 math.Int, arg5 uint64) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +146,21 @@ func (mr *MockModuleMockRecorder) CancelUnbondingDelegation(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUnbondingDelegation", reflect.TypeOf((*MockModule)(nil).CancelUnbondingDelegation), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// CancelUnbondingDelegationWithAccount mocks base method.
+func (m *MockModule) CancelUnbondingDelegationWithAccount(arg0 context.Context, arg1 types.ValAddress, arg2, arg3, arg4 math.Int, arg5 uint64, arg6 string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUnbondingDelegationWithAccount", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUnbondingDelegationWithAccount indicates an expected call of CancelUnbondingDelegationWithAccount.
+func (mr *MockModuleMockRecorder) CancelUnbondingDelegationWithAccount(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUnbondingDelegationWithAccount", reflect.TypeOf((*MockModule)(nil).CancelUnbondingDelegationWithAccount), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // Delegate mocks base method.
 func (m *MockModule) Delegate(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +176,66 @@ func (mr *MockModuleMockRecorder) Delegate(arg0, arg1, arg2, arg3, arg4 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegate", reflect.TypeOf((*MockModule)(nil).Delegate), arg0, arg1, arg2, arg3, arg4)
 }
 
+// DelegateWithAccount mocks base method.
+func (m *MockModule) DelegateWithAccount(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64, arg5 string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateWithAccount", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DelegateWithAccount indicates an expected call of DelegateWithAccount.
+func (mr *MockModuleMockRecorder) DelegateWithAccount(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateWithAccount", reflect.TypeOf((*MockModule)(nil).DelegateWithAccount), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// EstimateFee mocks base method.
+func (m *MockModule) EstimateFee(arg0 context.Context, arg1 []*blob.Blob) (*state.FeeEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateFee", arg0, arg1)
+	ret0, _ := ret[0].(*state.FeeEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateFee indicates an expected call of EstimateFee.
+func (mr *MockModuleMockRecorder) EstimateFee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateFee", reflect.TypeOf((*MockModule)(nil).EstimateFee), arg0, arg1)
+}
+
+// EstimateGas mocks base method.
+func (m *MockModule) EstimateGas(arg0 context.Context, arg1 []*blob.Blob) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockModuleMockRecorder) EstimateGas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockModule)(nil).EstimateGas), arg0, arg1)
+}
+
+// ImportAccount mocks base method.
+func (m *MockModule) ImportAccount(arg0 context.Context, arg1, arg2 string) (*state.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*state.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAccount indicates an expected call of ImportAccount.
+func (mr *MockModuleMockRecorder) ImportAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccount", reflect.TypeOf((*MockModule)(nil).ImportAccount), arg0, arg1, arg2)
+}
+
 // IsStopped mocks base method.
 func (m *MockModule) IsStopped(arg0 context.Context) bool {
 	m.ctrl.T.Helper()
@@ -145,6 +250,21 @@ func (mr *MockModuleMockRecorder) IsStopped(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStopped", reflect.TypeOf((*MockModule)(nil).IsStopped), arg0)
 }
 
+// ListAccounts mocks base method.
+func (m *MockModule) ListAccounts(arg0 context.Context) ([]*state.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", arg0)
+	ret0, _ := ret[0].([]*state.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockModuleMockRecorder) ListAccounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockModule)(nil).ListAccounts), arg0)
+}
+
 // QueryDelegation mocks base method.
 func (m *MockModule) QueryDelegation(arg0 context.Context, arg1 types.ValAddress) (*types0.QueryDelegationResponse, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +325,21 @@ func (mr *MockModuleMockRecorder) SubmitPayForBlob(arg0, arg1, arg2, arg3 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPayForBlob", reflect.TypeOf((*MockModule)(nil).SubmitPayForBlob), arg0, arg1, arg2, arg3)
 }
 
+// SubmitPayForBlobWithAccount mocks base method.
+func (m *MockModule) SubmitPayForBlobWithAccount(arg0 context.Context, arg1 math.Int, arg2 uint64, arg3 []*blob.Blob, arg4 string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitPayForBlobWithAccount", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitPayForBlobWithAccount indicates an expected call of SubmitPayForBlobWithAccount.
+func (mr *MockModuleMockRecorder) SubmitPayForBlobWithAccount(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPayForBlobWithAccount", reflect.TypeOf((*MockModule)(nil).SubmitPayForBlobWithAccount), arg0, arg1, arg2, arg3, arg4)
+}
+
 // SubmitTx mocks base method.
 func (m *MockModule) SubmitTx(arg0 context.Context, arg1 types1.Tx) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -235,6 +370,21 @@ func (mr *MockModuleMockRecorder) Transfer(arg0, arg1, arg2, arg3, arg4 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockModule)(nil).Transfer), arg0, arg1, arg2, arg3, arg4)
 }
 
+// TransferWithAccount mocks base method.
+func (m *MockModule) TransferWithAccount(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 math.Int, arg4 uint64, arg5 string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferWithAccount", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferWithAccount indicates an expected call of TransferWithAccount.
+func (mr *MockModuleMockRecorder) TransferWithAccount(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferWithAccount", reflect.TypeOf((*MockModule)(nil).TransferWithAccount), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // Undelegate mocks base method.
 func (m *MockModule) Undelegate(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -249,3 +399,18 @@ func (mr *MockModuleMockRecorder) Undelegate(arg0, arg1, arg2, arg3, arg4 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undelegate", reflect.TypeOf((*MockModule)(nil).Undelegate), arg0, arg1, arg2, arg3, arg4)
 }
+
+// UndelegateWithAccount mocks base method.
+func (m *MockModule) UndelegateWithAccount(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64, arg5 string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndelegateWithAccount", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndelegateWithAccount indicates an expected call of UndelegateWithAccount.
+func (mr *MockModuleMockRecorder) UndelegateWithAccount(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndelegateWithAccount", reflect.TypeOf((*MockModule)(nil).UndelegateWithAccount), arg0, arg1, arg2, arg3, arg4, arg5)
+}
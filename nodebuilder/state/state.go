@@ -38,6 +38,11 @@ type Module interface {
 	Transfer(
 		ctx context.Context, to state.AccAddress, amount, fee state.Int, gasLimit uint64,
 	) (*state.TxResponse, error)
+	// TransferWithAccount is Transfer signed by the given account of the node's keyring, referenced
+	// by its key name or address. The default account is used if it is empty.
+	TransferWithAccount(
+		ctx context.Context, to state.AccAddress, amount, fee state.Int, gasLimit uint64, account string,
+	) (*state.TxResponse, error)
 	// SubmitTx submits the given transaction/message to the
 	// Celestia network and blocks until the tx is included in
 	// a block.
@@ -49,6 +54,20 @@ type Module interface {
 		gasLim uint64,
 		blobs []*blob.Blob,
 	) (*state.TxResponse, error)
+	// SubmitPayForBlobWithAccount is SubmitPayForBlob signed by the given account of the node's
+	// keyring, referenced by its key name or address. The default account is used if it is empty.
+	SubmitPayForBlobWithAccount(
+		ctx context.Context,
+		fee state.Int,
+		gasLim uint64,
+		blobs []*blob.Blob,
+		account string,
+	) (*state.TxResponse, error)
+	// EstimateGas estimates the gas limit of a PayForBlob transaction of the given blobs.
+	EstimateGas(ctx context.Context, blobs []*blob.Blob) (uint64, error)
+	// EstimateFee estimates the gas limit, the current minimum gas price and the resulting fee of
+	// a PayForBlob transaction of the given blobs without submitting it.
+	EstimateFee(ctx context.Context, blobs []*blob.Blob) (*state.FeeEstimate, error)
 
 	// CancelUnbondingDelegation cancels a user's pending undelegation from a validator.
 	CancelUnbondingDelegation(
@@ -59,6 +78,18 @@ type Module interface {
 		fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// CancelUnbondingDelegationWithAccount is CancelUnbondingDelegation signed by the given account
+	// of the node's keyring, referenced by its key name or address. The default account is used if
+	// it is empty.
+	CancelUnbondingDelegationWithAccount(
+		ctx context.Context,
+		valAddr state.ValAddress,
+		amount,
+		height,
+		fee state.Int,
+		gasLim uint64,
+		account string,
+	) (*state.TxResponse, error)
 	// BeginRedelegate sends a user's delegated tokens to a new validator for redelegation.
 	BeginRedelegate(
 		ctx context.Context,
@@ -68,6 +99,17 @@ type Module interface {
 		fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// BeginRedelegateWithAccount is BeginRedelegate signed by the given account of the node's
+	// keyring, referenced by its key name or address. The default account is used if it is empty.
+	BeginRedelegateWithAccount(
+		ctx context.Context,
+		srcValAddr,
+		dstValAddr state.ValAddress,
+		amount,
+		fee state.Int,
+		gasLim uint64,
+		account string,
+	) (*state.TxResponse, error)
 	// Undelegate undelegates a user's delegated tokens, unbonding them from the current validator.
 	Undelegate(
 		ctx context.Context,
@@ -75,6 +117,15 @@ type Module interface {
 		amount, fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// UndelegateWithAccount is Undelegate signed by the given account of the node's keyring,
+	// referenced by its key name or address. The default account is used if it is empty.
+	UndelegateWithAccount(
+		ctx context.Context,
+		delAddr state.ValAddress,
+		amount, fee state.Int,
+		gasLim uint64,
+		account string,
+	) (*state.TxResponse, error)
 	// Delegate sends a user's liquid tokens to a validator for delegation.
 	Delegate(
 		ctx context.Context,
@@ -82,6 +133,15 @@ type Module interface {
 		amount, fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// DelegateWithAccount is Delegate signed by the given account of the node's keyring, referenced
+	// by its key name or address. The default account is used if it is empty.
+	DelegateWithAccount(
+		ctx context.Context,
+		delAddr state.ValAddress,
+		amount, fee state.Int,
+		gasLim uint64,
+		account string,
+	) (*state.TxResponse, error)
 
 	// QueryDelegation retrieves the delegation information between a delegator and a validator.
 	QueryDelegation(ctx context.Context, valAddr state.ValAddress) (*types.QueryDelegationResponse, error)
@@ -93,6 +153,16 @@ type Module interface {
 		srcValAddr,
 		dstValAddr state.ValAddress,
 	) (*types.QueryRedelegationsResponse, error)
+
+	// ListAccounts lists all the accounts of the node's keyring. Any of them can be used to sign
+	// transactions by passing its key name or address.
+	ListAccounts(ctx context.Context) ([]*state.Account, error)
+	// AddAccount generates a new account with the given key name in the node's keyring. The
+	// mnemonic of the account is returned only once.
+	AddAccount(ctx context.Context, name string) (*state.Account, error)
+	// ImportAccount imports the account with the given key name to the node's keyring from the
+	// mnemonic.
+	ImportAccount(ctx context.Context, name, mnemonic string) (*state.Account, error)
 }
 
 // API is a wrapper around Module for the RPC.
@@ -110,6 +180,14 @@ type API struct {
 			fee state.Int,
 			gasLimit uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		TransferWithAccount func(
+			ctx context.Context,
+			to state.AccAddress,
+			amount,
+			fee state.Int,
+			gasLimit uint64,
+			account string,
+		) (*state.TxResponse, error) `perm:"write"`
 		SubmitTx         func(ctx context.Context, tx state.Tx) (*state.TxResponse, error) `perm:"write"`
 		SubmitPayForBlob func(
 			ctx context.Context,
@@ -117,6 +195,15 @@ type API struct {
 			gasLim uint64,
 			blobs []*blob.Blob,
 		) (*state.TxResponse, error) `perm:"write"`
+		SubmitPayForBlobWithAccount func(
+			ctx context.Context,
+			fee state.Int,
+			gasLim uint64,
+			blobs []*blob.Blob,
+			account string,
+		) (*state.TxResponse, error) `perm:"write"`
+		EstimateGas               func(ctx context.Context, blobs []*blob.Blob) (uint64, error)             `perm:"read"`
+		EstimateFee               func(ctx context.Context, blobs []*blob.Blob) (*state.FeeEstimate, error) `perm:"read"`
 		CancelUnbondingDelegation func(
 			ctx context.Context,
 			valAddr state.ValAddress,
@@ -125,6 +212,15 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		CancelUnbondingDelegationWithAccount func(
+			ctx context.Context,
+			valAddr state.ValAddress,
+			amount,
+			height,
+			fee state.Int,
+			gasLim uint64,
+			account string,
+		) (*state.TxResponse, error) `perm:"write"`
 		BeginRedelegate func(
 			ctx context.Context,
 			srcValAddr,
@@ -133,6 +229,15 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		BeginRedelegateWithAccount func(
+			ctx context.Context,
+			srcValAddr,
+			dstValAddr state.ValAddress,
+			amount,
+			fee state.Int,
+			gasLim uint64,
+			account string,
+		) (*state.TxResponse, error) `perm:"write"`
 		Undelegate func(
 			ctx context.Context,
 			delAddr state.ValAddress,
@@ -140,6 +245,14 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		UndelegateWithAccount func(
+			ctx context.Context,
+			delAddr state.ValAddress,
+			amount,
+			fee state.Int,
+			gasLim uint64,
+			account string,
+		) (*state.TxResponse, error) `perm:"write"`
 		Delegate func(
 			ctx context.Context,
 			delAddr state.ValAddress,
@@ -147,6 +260,14 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		DelegateWithAccount func(
+			ctx context.Context,
+			delAddr state.ValAddress,
+			amount,
+			fee state.Int,
+			gasLim uint64,
+			account string,
+		) (*state.TxResponse, error) `perm:"write"`
 		QueryDelegation func(
 			ctx context.Context,
 			valAddr state.ValAddress,
@@ -160,6 +281,9 @@ type API struct {
 			srcValAddr,
 			dstValAddr state.ValAddress,
 		) (*types.QueryRedelegationsResponse, error) `perm:"read"`
+		ListAccounts  func(ctx context.Context) ([]*state.Account, error)                      `perm:"read"`
+		AddAccount    func(ctx context.Context, name string) (*state.Account, error)           `perm:"admin"`
+		ImportAccount func(ctx context.Context, name, mnemonic string) (*state.Account, error) `perm:"admin"`
 	}
 }
 
@@ -185,6 +309,17 @@ func (api *API) Transfer(
 	return api.Internal.Transfer(ctx, to, amount, fee, gasLimit)
 }
 
+func (api *API) TransferWithAccount(
+	ctx context.Context,
+	to state.AccAddress,
+	amount,
+	fee state.Int,
+	gasLimit uint64,
+	account string,
+) (*state.TxResponse, error) {
+	return api.Internal.TransferWithAccount(ctx, to, amount, fee, gasLimit, account)
+}
+
 func (api *API) SubmitTx(ctx context.Context, tx state.Tx) (*state.TxResponse, error) {
 	return api.Internal.SubmitTx(ctx, tx)
 }
@@ -198,6 +333,24 @@ func (api *API) SubmitPayForBlob(
 	return api.Internal.SubmitPayForBlob(ctx, fee, gasLim, blobs)
 }
 
+func (api *API) SubmitPayForBlobWithAccount(
+	ctx context.Context,
+	fee state.Int,
+	gasLim uint64,
+	blobs []*blob.Blob,
+	account string,
+) (*state.TxResponse, error) {
+	return api.Internal.SubmitPayForBlobWithAccount(ctx, fee, gasLim, blobs, account)
+}
+
+func (api *API) EstimateGas(ctx context.Context, blobs []*blob.Blob) (uint64, error) {
+	return api.Internal.EstimateGas(ctx, blobs)
+}
+
+func (api *API) EstimateFee(ctx context.Context, blobs []*blob.Blob) (*state.FeeEstimate, error) {
+	return api.Internal.EstimateFee(ctx, blobs)
+}
+
 func (api *API) CancelUnbondingDelegation(
 	ctx context.Context,
 	valAddr state.ValAddress,
@@ -209,6 +362,18 @@ func (api *API) CancelUnbondingDelegation(
 	return api.Internal.CancelUnbondingDelegation(ctx, valAddr, amount, height, fee, gasLim)
 }
 
+func (api *API) CancelUnbondingDelegationWithAccount(
+	ctx context.Context,
+	valAddr state.ValAddress,
+	amount,
+	height,
+	fee state.Int,
+	gasLim uint64,
+	account string,
+) (*state.TxResponse, error) {
+	return api.Internal.CancelUnbondingDelegationWithAccount(ctx, valAddr, amount, height, fee, gasLim, account)
+}
+
 func (api *API) BeginRedelegate(
 	ctx context.Context,
 	srcValAddr, dstValAddr state.ValAddress,
@@ -219,6 +384,17 @@ func (api *API) BeginRedelegate(
 	return api.Internal.BeginRedelegate(ctx, srcValAddr, dstValAddr, amount, fee, gasLim)
 }
 
+func (api *API) BeginRedelegateWithAccount(
+	ctx context.Context,
+	srcValAddr, dstValAddr state.ValAddress,
+	amount,
+	fee state.Int,
+	gasLim uint64,
+	account string,
+) (*state.TxResponse, error) {
+	return api.Internal.BeginRedelegateWithAccount(ctx, srcValAddr, dstValAddr, amount, fee, gasLim, account)
+}
+
 func (api *API) Undelegate(
 	ctx context.Context,
 	delAddr state.ValAddress,
@@ -229,6 +405,17 @@ func (api *API) Undelegate(
 	return api.Internal.Undelegate(ctx, delAddr, amount, fee, gasLim)
 }
 
+func (api *API) UndelegateWithAccount(
+	ctx context.Context,
+	delAddr state.ValAddress,
+	amount,
+	fee state.Int,
+	gasLim uint64,
+	account string,
+) (*state.TxResponse, error) {
+	return api.Internal.UndelegateWithAccount(ctx, delAddr, amount, fee, gasLim, account)
+}
+
 func (api *API) Delegate(
 	ctx context.Context,
 	delAddr state.ValAddress,
@@ -239,6 +426,17 @@ func (api *API) Delegate(
 	return api.Internal.Delegate(ctx, delAddr, amount, fee, gasLim)
 }
 
+func (api *API) DelegateWithAccount(
+	ctx context.Context,
+	delAddr state.ValAddress,
+	amount,
+	fee state.Int,
+	gasLim uint64,
+	account string,
+) (*state.TxResponse, error) {
+	return api.Internal.DelegateWithAccount(ctx, delAddr, amount, fee, gasLim, account)
+}
+
 func (api *API) QueryDelegation(ctx context.Context, valAddr state.ValAddress) (*types.QueryDelegationResponse, error) {
 	return api.Internal.QueryDelegation(ctx, valAddr)
 }
@@ -260,3 +458,15 @@ func (api *API) QueryRedelegations(
 func (api *API) Balance(ctx context.Context) (*state.Balance, error) {
 	return api.Internal.Balance(ctx)
 }
+
+func (api *API) ListAccounts(ctx context.Context) ([]*state.Account, error) {
+	return api.Internal.ListAccounts(ctx)
+}
+
+func (api *API) AddAccount(ctx context.Context, name string) (*state.Account, error) {
+	return api.Internal.AddAccount(ctx, name)
+}
+
+func (api *API) ImportAccount(ctx context.Context, name, mnemonic string) (*state.Account, error) {
+	return api.Internal.ImportAccount(ctx, name, mnemonic)
+}
@@ -45,6 +45,16 @@ func (s stubbedStateModule) Transfer(
 	return nil, ErrNoStateAccess
 }
 
+func (s stubbedStateModule) TransferWithAccount(
+	_ context.Context,
+	_ state.AccAddress,
+	_, _ state.Int,
+	_ uint64,
+	_ string,
+) (*state.TxResponse, error) {
+	return nil, ErrNoStateAccess
+}
+
 func (s stubbedStateModule) SubmitTx(context.Context, state.Tx) (*state.TxResponse, error) {
 	return nil, ErrNoStateAccess
 }
@@ -58,6 +68,24 @@ func (s stubbedStateModule) SubmitPayForBlob(
 	return nil, ErrNoStateAccess
 }
 
+func (s stubbedStateModule) SubmitPayForBlobWithAccount(
+	context.Context,
+	state.Int,
+	uint64,
+	[]*blob.Blob,
+	string,
+) (*state.TxResponse, error) {
+	return nil, ErrNoStateAccess
+}
+
+func (s stubbedStateModule) EstimateGas(context.Context, []*blob.Blob) (uint64, error) {
+	return 0, ErrNoStateAccess
+}
+
+func (s stubbedStateModule) EstimateFee(context.Context, []*blob.Blob) (*state.FeeEstimate, error) {
+	return nil, ErrNoStateAccess
+}
+
 func (s stubbedStateModule) CancelUnbondingDelegation(
 	_ context.Context,
 	_ state.ValAddress,
@@ -67,6 +95,16 @@ func (s stubbedStateModule) CancelUnbondingDelegation(
 	return nil, ErrNoStateAccess
 }
 
+func (s stubbedStateModule) CancelUnbondingDelegationWithAccount(
+	_ context.Context,
+	_ state.ValAddress,
+	_, _, _ state.Int,
+	_ uint64,
+	_ string,
+) (*state.TxResponse, error) {
+	return nil, ErrNoStateAccess
+}
+
 func (s stubbedStateModule) BeginRedelegate(
 	_ context.Context,
 	_, _ state.ValAddress,
@@ -76,6 +114,16 @@ func (s stubbedStateModule) BeginRedelegate(
 	return nil, ErrNoStateAccess
 }
 
+func (s stubbedStateModule) BeginRedelegateWithAccount(
+	_ context.Context,
+	_, _ state.ValAddress,
+	_, _ state.Int,
+	_ uint64,
+	_ string,
+) (*state.TxResponse, error) {
+	return nil, ErrNoStateAccess
+}
+
 func (s stubbedStateModule) Undelegate(
 	_ context.Context,
 	_ state.ValAddress,
@@ -85,6 +133,16 @@ func (s stubbedStateModule) Undelegate(
 	return nil, ErrNoStateAccess
 }
 
+func (s stubbedStateModule) UndelegateWithAccount(
+	_ context.Context,
+	_ state.ValAddress,
+	_, _ state.Int,
+	_ uint64,
+	_ string,
+) (*state.TxResponse, error) {
+	return nil, ErrNoStateAccess
+}
+
 func (s stubbedStateModule) Delegate(
 	_ context.Context,
 	_ state.ValAddress,
@@ -94,6 +152,16 @@ func (s stubbedStateModule) Delegate(
 	return nil, ErrNoStateAccess
 }
 
+func (s stubbedStateModule) DelegateWithAccount(
+	_ context.Context,
+	_ state.ValAddress,
+	_, _ state.Int,
+	_ uint64,
+	_ string,
+) (*state.TxResponse, error) {
+	return nil, ErrNoStateAccess
+}
+
 func (s stubbedStateModule) QueryDelegation(
 	context.Context,
 	state.ValAddress,
@@ -114,3 +182,15 @@ func (s stubbedStateModule) QueryRedelegations(
 ) (*types.QueryRedelegationsResponse, error) {
 	return nil, ErrNoStateAccess
 }
+
+func (s stubbedStateModule) ListAccounts(context.Context) ([]*state.Account, error) {
+	return nil, ErrNoStateAccess
+}
+
+func (s stubbedStateModule) AddAccount(context.Context, string) (*state.Account, error) {
+	return nil, ErrNoStateAccess
+}
+
+func (s stubbedStateModule) ImportAccount(context.Context, string, string) (*state.Account, error) {
+	return nil, ErrNoStateAccess
+}
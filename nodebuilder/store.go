@@ -179,6 +179,14 @@ func indexPath(base string) string {
 	return filepath.Join(base, "index")
 }
 
+func odsPath(base string) string {
+	return filepath.Join(base, "ods")
+}
+
+func quarantinePath(base string) string {
+	return filepath.Join(base, "quarantine")
+}
+
 func dataPath(base string) string {
 	return filepath.Join(base, "data")
 }
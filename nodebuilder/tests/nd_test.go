@@ -10,6 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/fx"
 
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
@@ -176,10 +179,11 @@ func replaceNDServer(cfg *nodebuilder.Config, handler network.StreamHandler) fx.
 		func(
 			host host.Host,
 			store *eds.Store,
+			hstore libhead.Store[*header.ExtendedHeader],
 			network p2p.Network,
 		) (*shrexnd.Server, error) {
 			cfg.Share.ShrExNDParams.WithNetworkID(network.String())
-			return shrexnd.NewServer(cfg.Share.ShrExNDParams, host, store)
+			return shrexnd.NewServer(cfg.Share.ShrExNDParams, host, store, hstore)
 		},
 		fx.OnStart(func(ctx context.Context, server *shrexnd.Server) error {
 			// replace handler for server
@@ -3,6 +3,7 @@ package share
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/rsmt2d"
@@ -27,6 +28,31 @@ func NewRoot(eds *rsmt2d.ExtendedDataSquare) (*Root, error) {
 	return &dah, nil
 }
 
+// SamplingResult describes the outcome of a single sampling round over the square of a header.
+type SamplingResult struct {
+	Height   uint64   `json:"height"`
+	DataHash DataHash `json:"data_hash"`
+	// Available reports whether the square was found available. Error describes why it was not.
+	Available bool           `json:"available"`
+	Error     string         `json:"error,omitempty"`
+	Started   time.Time      `json:"started"`
+	Duration  time.Duration  `json:"duration"`
+	Samples   []SampleResult `json:"samples"`
+}
+
+// SampleResult describes the outcome of the retrieval of a single sample. If the sample was
+// requested multiple times, e.g. from different sources, the last attempt is reported.
+type SampleResult struct {
+	Sample
+	// Source is the kind of the source the sample was requested from, e.g. "shrex" or "bitswap".
+	// It is empty if the sample was never requested.
+	Source string `json:"source,omitempty"`
+	// Peer is the ID of the peer that served the sample, if it is known.
+	Peer    string        `json:"peer,omitempty"`
+	Latency time.Duration `json:"latency"`
+	Error   string        `json:"error,omitempty"`
+}
+
 // Availability defines interface for validation of Shares' availability.
 //
 //go:generate mockgen -destination=availability/mocks/availability.go -package=mocks . Availability
@@ -35,3 +61,18 @@ type Availability interface {
 	// the Network.
 	SharesAvailable(context.Context, *header.ExtendedHeader) error
 }
+
+type revalidationKey struct{}
+
+// WithRevalidation instructs the Availability to validate the availability of Shares even if it
+// was already validated for the Root before.
+func WithRevalidation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, revalidationKey{}, true)
+}
+
+// IsRevalidation reports whether the availability of Shares has to be validated even if it was
+// already validated for the Root before.
+func IsRevalidation(ctx context.Context) bool {
+	revalidate, _ := ctx.Value(revalidationKey{}).(bool)
+	return revalidate
+}
@@ -73,8 +73,24 @@ func (fa *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 	}
 
 	// a hack to avoid loading the whole EDS in mem if we store it already.
-	if ok, _ := fa.store.Has(ctx, dah.Hash()); ok {
-		return nil
+	ok, err := fa.store.Has(ctx, dah.Hash())
+	if err != nil {
+		return fmt.Errorf("full availability: failed to check the store: %w", err)
+	}
+	if ok {
+		if !share.IsRevalidation(ctx) {
+			return nil
+		}
+		// the stored EDS has to be verified against the root, and retrieved again if it is corrupted
+		err = fa.verifyStored(ctx, dah)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		log.Warnw("stored eds failed revalidation, retrieving it again", "root", dah.String(), "err", err)
+		err = fa.store.Remove(ctx, dah.Hash())
+		if err != nil {
+			return fmt.Errorf("full availability: failed to remove corrupted eds: %w", err)
+		}
 	}
 
 	adder := ipld.NewProofsAdder(len(dah.RowRoots))
@@ -100,3 +116,19 @@ func (fa *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 	}
 	return nil
 }
+
+// verifyStored reads the stored EDS of the given root and checks that it is committed to the root.
+func (fa *ShareAvailability) verifyStored(ctx context.Context, dah *share.Root) error {
+	square, err := fa.store.Get(ctx, dah.Hash())
+	if err != nil {
+		return fmt.Errorf("reading eds: %w", err)
+	}
+	root, err := share.NewRoot(square)
+	if err != nil {
+		return fmt.Errorf("computing root: %w", err)
+	}
+	if !root.Equals(dah) {
+		return fmt.Errorf("root mismatch: computed %s", root.String())
+	}
+	return nil
+}
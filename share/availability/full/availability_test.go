@@ -80,3 +80,37 @@ func TestSharesAvailable_Full_ErrNotAvailable(t *testing.T) {
 		require.ErrorIs(t, err, share.ErrNotAvailable)
 	}
 }
+
+func TestSharesAvailable_Full_Revalidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	getter := mocks.NewMockGetter(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eds := edstest.RandEDS(t, 4)
+	dah, err := share.NewRoot(eds)
+	require.NoError(t, err)
+	eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+	avail := TestAvailability(t, getter)
+
+	// the stored square doesn't match the root it is stored under
+	corrupted := edstest.RandEDS(t, 4)
+	require.NoError(t, avail.store.Put(ctx, dah.Hash(), corrupted))
+
+	// the stored square is trusted without the revalidation
+	err = avail.SharesAvailable(ctx, eh)
+	require.NoError(t, err)
+
+	// the revalidation detects the corrupted square and retrieves it again
+	getter.EXPECT().GetEDS(gomock.Any(), gomock.Any()).Return(eds, nil)
+	err = avail.SharesAvailable(share.WithRevalidation(ctx), eh)
+	require.NoError(t, err)
+
+	stored, err := avail.store.Get(ctx, dah.Hash())
+	require.NoError(t, err)
+	require.True(t, stored.Equals(eds))
+
+	// the intact square passes the revalidation without being retrieved
+	err = avail.SharesAvailable(share.WithRevalidation(ctx), eh)
+	require.NoError(t, err)
+}
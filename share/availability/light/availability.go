@@ -58,8 +58,10 @@ func NewShareAvailability(
 	}
 }
 
-// SharesAvailable randomly samples `params.SampleAmount` amount of Shares committed to the given
-// ExtendedHeader. This way SharesAvailable subjectively verifies that Shares are available.
+// SharesAvailable randomly samples Shares committed to the given ExtendedHeader. The amount of
+// samples is either `params.SampleAmount` or, if `params.Confidence` is set, the amount required
+// to reach the confidence for the width of the square. This way SharesAvailable subjectively
+// verifies that Shares are available.
 func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header.ExtendedHeader) error {
 	dah := header.DAH
 	// short-circuit if the given root is minimum DAH of an empty data square
@@ -67,14 +69,15 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 		return nil
 	}
 
-	// do not sample over Root that has already been sampled
+	// do not sample over Root that has already been sampled, unless revalidation is requested
 	key := rootKey(dah)
-
-	la.dsLk.RLock()
-	exists, err := la.ds.Has(ctx, key)
-	la.dsLk.RUnlock()
-	if err != nil || exists {
-		return err
+	if !share.IsRevalidation(ctx) {
+		la.dsLk.RLock()
+		exists, err := la.ds.Has(ctx, key)
+		la.dsLk.RUnlock()
+		if err != nil || exists {
+			return err
+		}
 	}
 
 	log.Debugw("validate availability", "root", dah.String())
@@ -85,7 +88,10 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 			"err", err)
 		panic(err)
 	}
-	samples, err := SampleSquare(len(dah.RowRoots), int(la.params.SampleAmount))
+	width := len(dah.RowRoots)
+	amount := la.params.sampleAmount(width)
+	sampler := newSquareSampler(width, amount)
+	samples, err := sampler.generateSample(amount)
 	if err != nil {
 		return err
 	}
@@ -93,43 +99,23 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 	// indicate to the share.Getter that a blockservice session should be created. This
 	// functionality is optional and must be supported by the used share.Getter.
 	ctx = getters.WithSession(ctx)
+	// record the outcome of every sample for the sampling result of the height
+	recorder := newSamplingRecorder(header, samples)
+	ctx = getters.WithSampleObserver(ctx, recorder.observe)
 
-	log.Debugw("starting sampling session", "root", dah.String())
-	errs := make(chan error, len(samples))
-	for _, s := range samples {
-		go func(s Sample) {
-			log.Debugw("fetching share", "root", dah.String(), "row", s.Row, "col", s.Col)
-			_, err := la.getter.GetShare(ctx, header, s.Row, s.Col)
-			if err != nil {
-				log.Debugw("error fetching share", "root", dah.String(), "row", s.Row, "col", s.Col)
-			}
-			// we don't really care about Share bodies at this point
-			// it also means we now saved the Share in local storage
-			select {
-			case errs <- err:
-			case <-ctx.Done():
-			}
-		}(s)
+	log.Debugw("starting sampling session", "root", dah.String(), "samples", len(samples))
+	err = la.sample(ctx, header, sampler, samples, recorder)
+	if errors.Is(err, context.Canceled) {
+		return err
 	}
-
-	for range samples {
-		var err error
-		select {
-		case err = <-errs:
-		case <-ctx.Done():
-			err = ctx.Err()
-		}
-
-		if err != nil {
-			if errors.Is(err, context.Canceled) {
-				return err
-			}
-			log.Errorw("availability validation failed", "root", dah.String(), "err", err.Error())
-			if ipldFormat.IsNotFound(err) || errors.Is(err, context.DeadlineExceeded) {
-				return share.ErrNotAvailable
-			}
-			return err
+	// the result is stored even if the context has expired
+	la.storeSamplingResult(context.WithoutCancel(ctx), recorder.finish(err))
+	if err != nil {
+		log.Errorw("availability validation failed", "root", dah.String(), "err", err.Error())
+		if ipldFormat.IsNotFound(err) || errors.Is(err, context.DeadlineExceeded) {
+			return share.ErrNotAvailable
 		}
+		return err
 	}
 
 	la.dsLk.Lock()
@@ -141,6 +127,74 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, header *header
 	return nil
 }
 
+// sample retrieves the samples and replaces the failed ones with new samples until either all of
+// them succeed or `params.MaxResamples` additional samples were taken.
+func (la *ShareAvailability) sample(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	sampler *squareSampler,
+	samples []Sample,
+	recorder *samplingRecorder,
+) error {
+	var resampled int
+	for {
+		// all the samples are requested at once, so that the getter can batch them into as few
+		// requests as possible. We don't really care about Share bodies at this point.
+		_, err := la.getter.GetSamples(ctx, header, samples)
+		if err == nil || errors.Is(err, context.Canceled) || ctx.Err() != nil ||
+			resampled >= int(la.params.MaxResamples) {
+			return err
+		}
+
+		failed := la.failedSamples(ctx, header, samples)
+		switch {
+		case len(failed) == 0:
+			// all the samples succeeded once requested one by one
+			return nil
+		case resampled+len(failed) > int(la.params.MaxResamples):
+			return err
+		}
+		samples, err = sampler.generateSample(len(failed))
+		if err != nil {
+			return err
+		}
+		if len(samples) < len(failed) {
+			// the square ran out of unique samples
+			return share.ErrNotAvailable
+		}
+		resampled += len(samples)
+		recorder.add(samples)
+		log.Debugw("resampling", "root", header.DAH.String(), "failed", len(failed), "resampled", resampled)
+	}
+}
+
+// failedSamples requests every sample separately to find out which of them can't be retrieved.
+func (la *ShareAvailability) failedSamples(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	samples []Sample,
+) []Sample {
+	var (
+		lk     sync.Mutex
+		wg     sync.WaitGroup
+		failed []Sample
+	)
+	for _, s := range samples {
+		wg.Add(1)
+		go func(s Sample) {
+			defer wg.Done()
+			_, err := la.getter.GetSamples(ctx, header, []Sample{s})
+			if err != nil {
+				lk.Lock()
+				failed = append(failed, s)
+				lk.Unlock()
+			}
+		}(s)
+	}
+	wg.Wait()
+	return failed
+}
+
 func rootKey(root *share.Root) datastore.Key {
 	return datastore.NewKey(root.String())
 }
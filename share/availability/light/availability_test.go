@@ -4,11 +4,14 @@ import (
 	"context"
 	_ "embed"
 	"strconv"
+	"sync"
 	"testing"
 
+	"github.com/ipfs/go-datastore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/share"
 	availability_test "github.com/celestiaorg/celestia-node/share/availability/test"
@@ -64,6 +67,98 @@ func TestSharesAvailableHitsCache(t *testing.T) {
 	// should hit cache after putting
 	err = avail.SharesAvailable(ctx, eh)
 	require.NoError(t, err)
+
+	// revalidation should bypass the cache
+	err = avail.SharesAvailable(share.WithRevalidation(ctx), eh)
+	require.Error(t, err)
+}
+
+func TestSharesAvailableRecordsResult(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getter, eh := GetterWithRandSquare(t, 16)
+	avail := TestAvailability(getter)
+
+	_, err := avail.SamplingResult(ctx, eh.Height())
+	require.ErrorIs(t, err, ErrNoSamplingResult)
+
+	err = avail.SharesAvailable(ctx, eh)
+	require.NoError(t, err)
+
+	result, err := avail.SamplingResult(ctx, eh.Height())
+	require.NoError(t, err)
+	assert.True(t, result.Available)
+	assert.Empty(t, result.Error)
+	assert.Equal(t, share.DataHash(eh.DAH.Hash()), result.DataHash)
+	assert.Len(t, result.Samples, int(DefaultSampleAmount))
+	for _, s := range result.Samples {
+		assert.Equal(t, "bitswap", s.Source)
+		assert.Empty(t, s.Error)
+		assert.Positive(t, s.Latency)
+	}
+
+	// the failed rounds are recorded as well
+	bServ := ipld.NewMemBlockservice()
+	dah := availability_test.RandFillBS(t, 16, bServ)
+	eh = headertest.RandExtendedHeaderWithRoot(t, dah)
+	err = avail.SharesAvailable(ctx, eh)
+	require.Error(t, err)
+
+	result, err = avail.SamplingResult(ctx, eh.Height())
+	require.NoError(t, err)
+	assert.False(t, result.Available)
+	assert.NotEmpty(t, result.Error)
+}
+
+func TestSharesAvailableResamples(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getter, eh := GetterWithRandSquare(t, 16)
+
+	// without resampling a single failed sample fails the whole header
+	avail := NewShareAvailability(&flakyGetter{Getter: getter}, datastore.NewMapDatastore())
+	err := avail.SharesAvailable(ctx, eh)
+	require.Error(t, err)
+
+	avail = NewShareAvailability(&flakyGetter{Getter: getter}, datastore.NewMapDatastore(), WithMaxResamples(1))
+	err = avail.SharesAvailable(ctx, eh)
+	require.NoError(t, err)
+
+	// the failed sample is recorded along with its replacement
+	result, err := avail.SamplingResult(ctx, eh.Height())
+	require.NoError(t, err)
+	assert.True(t, result.Available)
+	assert.Len(t, result.Samples, int(DefaultSampleAmount)+1)
+}
+
+// flakyGetter fails to retrieve the first sample it is asked for.
+type flakyGetter struct {
+	share.Getter
+
+	lk  sync.Mutex
+	bad *share.Sample
+}
+
+func (fg *flakyGetter) GetSamples(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	samples []share.Sample,
+) ([]share.Share, error) {
+	fg.lk.Lock()
+	if fg.bad == nil {
+		fg.bad = &samples[0]
+	}
+	bad := *fg.bad
+	fg.lk.Unlock()
+
+	for _, s := range samples {
+		if s == bad {
+			return nil, share.ErrNotFound
+		}
+	}
+	return fg.Getter.GetSamples(ctx, header, samples)
 }
 
 func TestSharesAvailableEmptyRoot(t *testing.T) {
@@ -0,0 +1,30 @@
+package light
+
+// SampleAmountForConfidence returns the minimum amount of unique samples a light node must
+// successfully retrieve from a square of the given width to detect with the given confidence that
+// the square can't be reconstructed.
+//
+// The square becomes unrecoverable once at least (k+1)^2 of its (2k)^2 shares are withheld, where
+// k is the width of the original data square. The probability that none of s unique samples hits
+// the withheld shares is the product of (A-i)/(N-i) for i in [0, s), where N is the amount of
+// shares of the square and A = N-(k+1)^2 is the amount of the shares that can still be served.
+// The returned amount is the smallest s for which that probability doesn't exceed 1-confidence.
+func SampleAmountForConfidence(squareWidth int, confidence float64) uint {
+	if squareWidth < 2 || confidence <= 0 {
+		return 1
+	}
+
+	k := squareWidth / 2
+	total := squareWidth * squareWidth
+	served := total - (k+1)*(k+1)
+
+	missProbability := 1.0
+	for s := 0; s < served; s++ {
+		missProbability *= float64(served-s) / float64(total-s)
+		if missProbability <= 1-confidence {
+			return uint(s + 1)
+		}
+	}
+	// any sample beyond the servable shares hits the withheld ones
+	return uint(served + 1)
+}
@@ -0,0 +1,43 @@
+package light
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleAmountForConfidence(t *testing.T) {
+	tests := []struct {
+		width      int
+		confidence float64
+		expected   uint
+	}{
+		// the whole square has to be withheld, so any sample detects it
+		{width: 2, confidence: 0.9999, expected: 1},
+		// 7 of 16 shares can be served: 7/16*6/15*5/14*4/13*3/12 < 0.01
+		{width: 4, confidence: 0.99, expected: 5},
+		// 7/16*6/15*5/14*4/13*3/12*2/11*1/10 < 0.0001
+		{width: 4, confidence: 0.9999, expected: 7},
+		// the probability of missing the withheld shares approaches (3/4)^s for large squares
+		{width: 256, confidence: 0.99, expected: 16},
+		{width: 256, confidence: 0.9999, expected: 32},
+	}
+
+	for _, tt := range tests {
+		amount := SampleAmountForConfidence(tt.width, tt.confidence)
+		assert.Equal(t, tt.expected, amount, "width: %d, confidence: %v", tt.width, tt.confidence)
+	}
+}
+
+func TestParameters_SampleAmount(t *testing.T) {
+	params := DefaultParameters()
+	assert.Equal(t, int(DefaultSampleAmount), params.sampleAmount(256))
+
+	params.Confidence = 0.9999
+	assert.Equal(t, 32, params.sampleAmount(256))
+	// SampleAmount is the lower bound of the amount of samples
+	assert.Equal(t, int(DefaultSampleAmount), params.sampleAmount(4))
+
+	params.Confidence = 1
+	assert.Error(t, params.Validate())
+}
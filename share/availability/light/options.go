@@ -14,6 +14,16 @@ var (
 // availability implementation
 type Parameters struct {
 	SampleAmount uint // The minimum required amount of samples to perform
+
+	// Confidence is the target probability of detecting that a square can't be reconstructed.
+	// If set, the amount of samples of every header is computed from the width of its square with
+	// SampleAmountForConfidence, and SampleAmount is used as its lower bound. It is disabled if 0.
+	Confidence float64
+
+	// MaxResamples is the maximum amount of additional samples taken per header to replace the
+	// samples that failed. A header is considered available only if the required amount of
+	// samples succeeds. Resampling is disabled if 0.
+	MaxResamples uint
 }
 
 // Option is a function that configures light availability Parameters
@@ -38,9 +48,27 @@ func (p *Parameters) Validate() error {
 		)
 	}
 
+	if p.Confidence < 0 || p.Confidence >= 1 {
+		return fmt.Errorf(
+			"light availability: invalid option: value %s was %v, where it should be %s",
+			"Confidence",
+			p.Confidence,
+			"in range [0, 1)",
+		)
+	}
+
 	return nil
 }
 
+// sampleAmount returns the amount of samples to perform over the square of the given width.
+func (p *Parameters) sampleAmount(squareWidth int) int {
+	amount := p.SampleAmount
+	if p.Confidence > 0 {
+		amount = max(amount, SampleAmountForConfidence(squareWidth, p.Confidence))
+	}
+	return int(amount)
+}
+
 // WithSampleAmount is a functional option that the Availability interface
 // implementers use to set the SampleAmount configuration param
 func WithSampleAmount(sampleAmount uint) Option {
@@ -48,3 +76,19 @@ func WithSampleAmount(sampleAmount uint) Option {
 		p.SampleAmount = sampleAmount
 	}
 }
+
+// WithConfidence is a functional option that the Availability interface
+// implementers use to set the Confidence configuration param
+func WithConfidence(confidence float64) Option {
+	return func(p *Parameters) {
+		p.Confidence = confidence
+	}
+}
+
+// WithMaxResamples is a functional option that the Availability interface
+// implementers use to set the MaxResamples configuration param
+func WithMaxResamples(maxResamples uint) Option {
+	return func(p *Parameters) {
+		p.MaxResamples = maxResamples
+	}
+}
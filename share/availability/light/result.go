@@ -0,0 +1,135 @@
+package light
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/getters"
+)
+
+// ErrNoSamplingResult is returned when there is no recorded sampling result for the height.
+var ErrNoSamplingResult = errors.New("light availability: no sampling result for the height")
+
+var heightKeyPrefix = datastore.NewKey("height")
+
+// SamplingResult returns the result of the last sampling round over the header of the given
+// height. Results are recorded for both successful and failed rounds, except the ones that were
+// canceled.
+func (la *ShareAvailability) SamplingResult(ctx context.Context, height uint64) (*share.SamplingResult, error) {
+	la.dsLk.RLock()
+	raw, err := la.ds.Get(ctx, heightKey(height))
+	la.dsLk.RUnlock()
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, ErrNoSamplingResult
+	}
+	if err != nil {
+		return nil, fmt.Errorf("light availability: getting sampling result: %w", err)
+	}
+
+	var result share.SamplingResult
+	if err = json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("light availability: unmarshaling sampling result: %w", err)
+	}
+	return &result, nil
+}
+
+// storeSamplingResult persists the result of the sampling round under the height of its header.
+func (la *ShareAvailability) storeSamplingResult(ctx context.Context, result *share.SamplingResult) {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		log.Errorw("marshaling sampling result", "height", result.Height, "err", err)
+		return
+	}
+
+	la.dsLk.Lock()
+	err = la.ds.Put(ctx, heightKey(result.Height), raw)
+	la.dsLk.Unlock()
+	if err != nil {
+		log.Errorw("storing sampling result to disk", "height", result.Height, "err", err)
+	}
+}
+
+func heightKey(height uint64) datastore.Key {
+	return heightKeyPrefix.ChildString(strconv.FormatUint(height, 10))
+}
+
+// samplingRecorder collects the outcomes of the retrieval of every sample of a sampling round
+// from the attempts reported by the share.Getter.
+type samplingRecorder struct {
+	lk      sync.Mutex
+	result  *share.SamplingResult
+	indexes map[share.Sample]int
+}
+
+func newSamplingRecorder(header *header.ExtendedHeader, samples []Sample) *samplingRecorder {
+	r := &samplingRecorder{
+		result: &share.SamplingResult{
+			Height:   header.Height(),
+			DataHash: header.DAH.Hash(),
+			Started:  time.Now(),
+			Samples:  make([]share.SampleResult, len(samples)),
+		},
+		indexes: make(map[share.Sample]int, len(samples)),
+	}
+	for i, s := range samples {
+		r.result.Samples[i].Sample = s
+		r.indexes[s] = i
+	}
+	return r
+}
+
+// add extends the result with the new samples.
+func (r *samplingRecorder) add(samples []Sample) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	for _, s := range samples {
+		r.indexes[s] = len(r.result.Samples)
+		r.result.Samples = append(r.result.Samples, share.SampleResult{Sample: s})
+	}
+}
+
+// observe implements getters.SampleObserver.
+func (r *samplingRecorder) observe(attempt getters.SampleAttempt) {
+	res := share.SampleResult{
+		Source:  attempt.Source,
+		Latency: attempt.Latency,
+	}
+	if attempt.Peer != "" {
+		res.Peer = attempt.Peer.String()
+	}
+	if attempt.Err != nil {
+		res.Error = attempt.Err.Error()
+	}
+
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	for _, s := range attempt.Samples {
+		idx, ok := r.indexes[s]
+		if !ok {
+			continue
+		}
+		res.Sample = s
+		r.result.Samples[idx] = res
+	}
+}
+
+// finish completes the result of the sampling round with its outcome.
+func (r *samplingRecorder) finish(err error) *share.SamplingResult {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	r.result.Duration = time.Since(r.result.Started)
+	r.result.Available = err == nil
+	if err != nil {
+		r.result.Error = err.Error()
+	}
+	return r.result
+}
@@ -4,22 +4,18 @@ package light
 import (
 	crand "crypto/rand"
 	"math/big"
+
+	"github.com/celestiaorg/celestia-node/share"
 )
 
 // Sample is a point in 2D space over square.
-type Sample struct {
-	Row, Col int
-}
+type Sample = share.Sample
 
 // SampleSquare randomly picks *num* unique points from the given *width* square
 // and returns them as samples.
 func SampleSquare(squareWidth int, num int) ([]Sample, error) {
 	ss := newSquareSampler(squareWidth, num)
-	err := ss.generateSample(num)
-	if err != nil {
-		return nil, err
-	}
-	return ss.samples(), nil
+	return ss.generateSample(num)
 }
 
 type squareSampler struct {
@@ -34,14 +30,18 @@ func newSquareSampler(squareWidth int, expectedSamples int) *squareSampler {
 	}
 }
 
-// generateSample randomly picks unique point on a 2D spaces.
-func (ss *squareSampler) generateSample(num int) error {
+// generateSample randomly picks unique point on a 2D spaces. The points are unique across all the
+// calls, and only the points picked by the call are returned.
+func (ss *squareSampler) generateSample(num int) ([]Sample, error) {
 	if num > ss.squareWidth*ss.squareWidth {
 		num = ss.squareWidth
 	}
+	if free := ss.squareWidth*ss.squareWidth - len(ss.smpls); num > free {
+		num = free
+	}
 
-	done := 0
-	for done < num {
+	picked := make([]Sample, 0, num)
+	for len(picked) < num {
 		s := Sample{
 			Row: randInt(ss.squareWidth),
 			Col: randInt(ss.squareWidth),
@@ -51,19 +51,11 @@ func (ss *squareSampler) generateSample(num int) error {
 			continue
 		}
 
-		done++
 		ss.smpls[s] = struct{}{}
+		picked = append(picked, s)
 	}
 
-	return nil
-}
-
-func (ss *squareSampler) samples() []Sample {
-	samples := make([]Sample, 0, len(ss.smpls))
-	for s := range ss.smpls {
-		samples = append(samples, s)
-	}
-	return samples
+	return picked, nil
 }
 
 func randInt(max int) int {
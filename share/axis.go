@@ -0,0 +1,82 @@
+package share
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// AxisHalf represents the first half of the shares of a row or column of an EDS along with their
+// inclusion proof against the root of the axis. The second half of the axis is the erasure coding
+// of the first one, so the whole axis can be recomputed with Extend.
+type AxisHalf struct {
+	Shares []Share    `json:"shares"`
+	Proof  *nmt.Proof `json:"proof"`
+}
+
+// AxisRoots returns the roots of the given axis of the Root.
+func AxisRoots(root *Root, axis rsmt2d.Axis) [][]byte {
+	if axis == rsmt2d.Col {
+		return root.ColumnRoots
+	}
+	return root.RowRoots
+}
+
+// Verify validates the AxisHalf of the row or column with the given index against the Root using
+// the nmt inclusion proof.
+func (a *AxisHalf) Verify(root *Root, idx int, axis rsmt2d.Axis) error {
+	roots := AxisRoots(root, axis)
+	width := len(roots)
+	if idx < 0 || idx >= width {
+		return ErrOutOfBounds
+	}
+	half := width / 2
+	if len(a.Shares) != half {
+		return fmt.Errorf("amount of shares differs from half of the axis: expected %d, got %d",
+			half, len(a.Shares))
+	}
+	if a.Proof == nil || a.Proof.Start() != 0 || a.Proof.End() != half {
+		return errors.New("proof doesn't match the first half of the axis")
+	}
+
+	nth := nmt.NewNmtHasher(sha256.New(), NamespaceSize, a.Proof.IsMaxNamespaceIDIgnored())
+	leafHashes := make([][]byte, 0, half)
+	for _, shr := range a.Shares {
+		if len(shr) != Size {
+			return fmt.Errorf("invalid share size: %d", len(shr))
+		}
+		// the leaves outside the original data square are namespaced with the parity namespace
+		namespace := ParitySharesNamespace
+		if idx < half {
+			namespace = GetNamespace(shr)
+		}
+		leafHash, err := nth.HashLeaf(append(namespace, shr...))
+		if err != nil {
+			return err
+		}
+		leafHashes = append(leafHashes, leafHash)
+	}
+	// the leaves of the original data square have different namespaces, so the proof is converted
+	// to an absence one, which skips the namespace check of the inclusion proof
+	proof := nmt.NewAbsenceProof(
+		a.Proof.Start(),
+		a.Proof.End(),
+		a.Proof.Nodes(),
+		leafHashes[0],
+		a.Proof.IsMaxNamespaceIDIgnored(),
+	)
+	return verifyLeafHashes(nth, proof, GetNamespace(a.Shares[0]), leafHashes, roots[idx])
+}
+
+// Extend computes the second half of the axis from the first one and returns all the shares of
+// the axis.
+func (a *AxisHalf) Extend() ([]Share, error) {
+	parity, err := DefaultRSMT2DCodec().Encode(a.Shares)
+	if err != nil {
+		return nil, fmt.Errorf("encoding axis half: %w", err)
+	}
+	return append(append(make([]Share, 0, 2*len(a.Shares)), a.Shares...), parity...), nil
+}
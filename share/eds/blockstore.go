@@ -160,7 +160,7 @@ func (bs *blockstore) getReadOnlyBlockstore(ctx context.Context, cid cid.Cid) (*
 	}
 
 	// load accessor to the blockstore cache and use it as blockstoreCloser
-	accessor, err = bs.store.cache.Load().Second().GetOrLoad(ctx, shardKey, bs.store.getAccessor)
+	accessor, err = bs.store.cache.Load().Second().GetOrLoad(ctx, shardKey, bs.store.storage.getAccessor)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get accessor for shard %s: %w", shardKey, err)
 	}
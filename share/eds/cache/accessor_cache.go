@@ -5,20 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/filecoin-project/dagstore"
 	"github.com/filecoin-project/dagstore/shard"
-	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 const defaultCloseTimeout = time.Minute
 
 var _ Cache = (*AccessorCache)(nil)
 
-// AccessorCache implements the Cache interface using an LRU cache backend.
+// AccessorCache implements the Cache interface using a configurable eviction policy. It is limited
+// by the amount of accessors and, optionally, by their total size in bytes.
 type AccessorCache struct {
 	// The name is a prefix that will be used for cache metrics if they are enabled.
 	name string
@@ -26,13 +27,50 @@ type AccessorCache struct {
 	// of using only one lock or one lock per key, we stripe the shard keys across 256 locks. 256 is
 	// chosen because it 0-255 is the range of values we get looking at the last byte of the key.
 	stripedLocks [256]sync.Mutex
+
+	// lk guards the cached accessors, their total size and the policy.
+	lk sync.Mutex
 	// Caches the blockstore for a given shard for shard read affinity, i.e., further reads will likely
 	// be from the same shard. Maps (shard key -> blockstore).
-	cache *lru.Cache[shard.Key, *accessorWithBlockstore]
+	cache  map[shard.Key]*accessorWithBlockstore
+	bytes  int64
+	policy policy
+
+	policyType PolicyType
+	maxEntries int
+	maxBytes   int64
+	ttl        time.Duration
+
+	hits, misses atomic.Uint64
 
 	metrics *metrics
 }
 
+// Option configures the AccessorCache.
+type Option func(*AccessorCache)
+
+// WithPolicy sets the eviction policy of the AccessorCache. LRUPolicy is used by default.
+func WithPolicy(tp PolicyType) Option {
+	return func(bc *AccessorCache) {
+		bc.policyType = tp
+	}
+}
+
+// WithTTL sets the time accessors are served for by the TTLPolicy.
+func WithTTL(ttl time.Duration) Option {
+	return func(bc *AccessorCache) {
+		bc.ttl = ttl
+	}
+}
+
+// WithMaxBytes limits the total size of the cached accessors in addition to their amount. Accessors
+// report their size by implementing Sizer and are counted as empty otherwise.
+func WithMaxBytes(maxBytes int64) Option {
+	return func(bc *AccessorCache) {
+		bc.maxBytes = maxBytes
+	}
+}
+
 // accessorWithBlockstore is the value that we store in the blockstore Cache. It implements the
 // Accessor interface.
 type accessorWithBlockstore struct {
@@ -42,6 +80,11 @@ type accessorWithBlockstore struct {
 	// accessor reopens the underlying CAR.
 	bs dagstore.ReadBlockstore
 
+	// size is the size of the accessor in bytes, if it reports one.
+	size int64
+	// hits counts the requests served by the accessor from the cache.
+	hits atomic.Uint64
+
 	done     chan struct{}
 	refs     atomic.Int32
 	isClosed bool
@@ -109,33 +152,51 @@ func (s *accessorWithBlockstore) close() error {
 	return nil
 }
 
-func NewAccessorCache(name string, cacheSize int) (*AccessorCache, error) {
+// NewAccessorCache creates a new AccessorCache keeping up to cacheSize accessors.
+func NewAccessorCache(name string, cacheSize int, opts ...Option) (*AccessorCache, error) {
 	bc := &AccessorCache{
-		name: name,
+		name:       name,
+		cache:      make(map[shard.Key]*accessorWithBlockstore),
+		policyType: LRUPolicy,
+		maxEntries: cacheSize,
 	}
-	// Instantiate the blockstore Cache.
-	bslru, err := lru.NewWithEvict[shard.Key, *accessorWithBlockstore](cacheSize, bc.evictFn())
-	if err != nil {
+	for _, opt := range opts {
+		opt(bc)
+	}
+
+	if cacheSize <= 0 {
+		return nil, errors.New("failed to instantiate blockstore cache: must provide a positive size")
+	}
+	if bc.maxBytes < 0 {
+		return nil, fmt.Errorf("failed to instantiate blockstore cache: negative max bytes %d", bc.maxBytes)
+	}
+	if err := bc.policyType.Validate(); err != nil {
 		return nil, fmt.Errorf("failed to instantiate blockstore cache: %w", err)
 	}
-	bc.cache = bslru
+	if bc.policyType == TTLPolicy && bc.ttl <= 0 {
+		return nil, errors.New("failed to instantiate blockstore cache: ttl policy requires a positive ttl")
+	}
+
+	capacity := int64(cacheSize)
+	if bc.maxBytes > 0 {
+		capacity = bc.maxBytes
+	}
+	bc.policy = newPolicy(bc.policyType, capacity, bc.ttl)
 	return bc, nil
 }
 
-// evictFn will be invoked when an item is evicted from the cache.
-func (bc *AccessorCache) evictFn() func(shard.Key, *accessorWithBlockstore) {
-	return func(_ shard.Key, abs *accessorWithBlockstore) {
-		// we can release accessor from cache early, while it is being closed in parallel routine
-		go func() {
-			err := abs.close()
-			if err != nil {
-				bc.metrics.observeEvicted(true)
-				log.Errorf("couldn't close accessor after cache eviction: %s", err)
-				return
-			}
-			bc.metrics.observeEvicted(false)
-		}()
-	}
+// evict closes the evicted accessor.
+func (bc *AccessorCache) evict(abs *accessorWithBlockstore) {
+	// we can release accessor from cache early, while it is being closed in parallel routine
+	go func() {
+		err := abs.close()
+		if err != nil {
+			bc.metrics.observeEvicted(true)
+			log.Errorf("couldn't close accessor after cache eviction: %s", err)
+			return
+		}
+		bc.metrics.observeEvicted(false)
+	}()
 }
 
 // Get retrieves the Accessor for a given shard key from the Cache. If the Accessor is not in
@@ -147,21 +208,69 @@ func (bc *AccessorCache) Get(key shard.Key) (Accessor, error) {
 
 	accessor, err := bc.get(key)
 	if err != nil {
-		bc.metrics.observeGet(false)
+		bc.observeGet(false)
 		return nil, err
 	}
-	bc.metrics.observeGet(true)
+	bc.observeGet(true)
 	return newRefCloser(accessor)
 }
 
 func (bc *AccessorCache) get(key shard.Key) (*accessorWithBlockstore, error) {
-	abs, ok := bc.cache.Get(key)
+	bc.lk.Lock()
+	defer bc.lk.Unlock()
+
+	abs, ok := bc.cache[key]
 	if !ok {
 		return nil, errCacheMiss
 	}
+	if bc.policy.expired(key) {
+		bc.policy.remove(key)
+		bc.drop(key, abs)
+		return nil, errCacheMiss
+	}
+	bc.policy.touch(key)
+	abs.hits.Add(1)
 	return abs, nil
 }
 
+// add caches the accessor and evicts accessors until the cache fits its limits.
+func (bc *AccessorCache) add(key shard.Key, abs *accessorWithBlockstore) {
+	bc.lk.Lock()
+	defer bc.lk.Unlock()
+
+	if old, ok := bc.cache[key]; ok {
+		// the previous accessor is closed already and waits for its readers
+		bc.policy.remove(key)
+		bc.drop(key, old)
+	}
+
+	bc.cache[key] = abs
+	bc.bytes += abs.size
+	weight := int64(1)
+	if bc.maxBytes > 0 {
+		weight = abs.size
+	}
+	bc.policy.add(key, weight)
+
+	for len(bc.cache) > bc.maxEntries || (bc.maxBytes > 0 && bc.bytes > bc.maxBytes) {
+		victim, ok := bc.policy.evict()
+		if !ok {
+			break
+		}
+		if evicted, ok := bc.cache[victim]; ok {
+			bc.drop(victim, evicted)
+		}
+	}
+}
+
+// drop removes the accessor from the cache and closes it in the background. The policy must stop
+// tracking the key beforehand.
+func (bc *AccessorCache) drop(key shard.Key, abs *accessorWithBlockstore) {
+	delete(bc.cache, key)
+	bc.bytes -= abs.size
+	bc.evict(abs)
+}
+
 // GetOrLoad attempts to get an item from the cache, and if not found, invokes
 // the provided loader function to load it.
 func (bc *AccessorCache) GetOrLoad(
@@ -178,10 +287,11 @@ func (bc *AccessorCache) GetOrLoad(
 		// return accessor, only of it is not closed yet
 		accessorWithRef, err := newRefCloser(abs)
 		if err == nil {
-			bc.metrics.observeGet(true)
+			bc.observeGet(true)
 			return accessorWithRef, nil
 		}
 	}
+	bc.observeGet(false)
 
 	// accessor not found in cache, so load new one using loader
 	accessor, err := loader(ctx, key)
@@ -192,14 +302,17 @@ func (bc *AccessorCache) GetOrLoad(
 	abs = &accessorWithBlockstore{
 		shardAccessor: accessor,
 	}
+	if sizer, ok := accessor.(Sizer); ok {
+		abs.size = sizer.Size()
+	}
 
 	// Create a new accessor first to increment the reference count in it, so it cannot get evicted
-	// from the inner lru cache before it is used.
+	// from the inner cache before it is used.
 	accessorWithRef, err := newRefCloser(abs)
 	if err != nil {
 		return nil, err
 	}
-	bc.cache.Add(key, abs)
+	bc.add(key, abs)
 	return accessorWithRef, nil
 }
 
@@ -207,20 +320,79 @@ func (bc *AccessorCache) GetOrLoad(
 func (bc *AccessorCache) Remove(key shard.Key) error {
 	lk := &bc.stripedLocks[shardKeyToStriped(key)]
 	lk.Lock()
-	accessor, err := bc.get(key)
+	bc.lk.Lock()
+	accessor, ok := bc.cache[key]
+	if ok {
+		bc.policy.remove(key)
+		delete(bc.cache, key)
+		bc.bytes -= accessor.size
+	}
+	bc.lk.Unlock()
 	lk.Unlock()
-	if errors.Is(err, errCacheMiss) {
+	if !ok {
 		// item is not in cache
 		return nil
 	}
-	if err = accessor.close(); err != nil {
+	if err := accessor.close(); err != nil {
+		bc.metrics.observeEvicted(true)
 		return err
 	}
-	// The cache will call evictFn on removal, where accessor close will be called.
-	bc.cache.Remove(key)
+	bc.metrics.observeEvicted(false)
 	return nil
 }
 
+// Stats returns the contents of the cache along with its hit ratio.
+func (bc *AccessorCache) Stats() Stats {
+	hits, misses := bc.hits.Load(), bc.misses.Load()
+	stats := Stats{
+		Name:       bc.name,
+		Policy:     bc.policyType,
+		MaxEntries: bc.maxEntries,
+		MaxBytes:   bc.maxBytes,
+		Hits:       hits,
+		Misses:     misses,
+	}
+	if hits+misses > 0 {
+		stats.HitRatio = float64(hits) / float64(hits+misses)
+	}
+
+	bc.lk.Lock()
+	defer bc.lk.Unlock()
+	stats.Bytes = bc.bytes
+	stats.Entries = make([]EntryStats, 0, len(bc.cache))
+	for key, abs := range bc.cache {
+		stats.Entries = append(stats.Entries, EntryStats{
+			Key:  key.String(),
+			Size: abs.size,
+			Hits: abs.hits.Load(),
+		})
+	}
+	// the most requested accessors go first
+	sort.Slice(stats.Entries, func(i, j int) bool {
+		if stats.Entries[i].Hits != stats.Entries[j].Hits {
+			return stats.Entries[i].Hits > stats.Entries[j].Hits
+		}
+		return stats.Entries[i].Key < stats.Entries[j].Key
+	})
+	return stats
+}
+
+// len returns the amount of cached accessors.
+func (bc *AccessorCache) len() int {
+	bc.lk.Lock()
+	defer bc.lk.Unlock()
+	return len(bc.cache)
+}
+
+func (bc *AccessorCache) observeGet(found bool) {
+	if found {
+		bc.hits.Add(1)
+	} else {
+		bc.misses.Add(1)
+	}
+	bc.metrics.observeGet(found)
+}
+
 // EnableMetrics enables metrics for the cache.
 func (bc *AccessorCache) EnableMetrics() error {
 	var err error
@@ -283,6 +283,83 @@ func TestAccessorCache(t *testing.T) {
 		require.NoError(t, err)
 		mock2.checkClosed(t, false)
 	})
+
+	t.Run("removed by eviction when over max bytes", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		cache, err := NewAccessorCache("test", 10, WithMaxBytes(10))
+		require.NoError(t, err)
+
+		load := func(key shard.Key, mock *mockAccessor) {
+			ac, err := cache.GetOrLoad(ctx, key, func(ctx context.Context, key shard.Key) (Accessor, error) {
+				return mock, nil
+			})
+			require.NoError(t, err)
+			require.NoError(t, ac.Close())
+		}
+
+		key1, key2 := shard.KeyFromString("key1"), shard.KeyFromString("key2")
+		mock1, mock2 := &mockAccessor{data: []byte("123456")}, &mockAccessor{data: []byte("1234")}
+		load(key1, mock1)
+		load(key2, mock2)
+		require.EqualValues(t, 10, cache.Stats().Bytes)
+
+		// the third accessor doesn't fit along with the first one
+		load(shard.KeyFromString("key3"), &mockAccessor{data: []byte("1")})
+		mock1.checkClosed(t, true)
+		mock2.checkClosed(t, false)
+
+		_, err = cache.Get(key1)
+		require.ErrorIs(t, err, errCacheMiss)
+		require.EqualValues(t, 5, cache.Stats().Bytes)
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		cache, err := NewAccessorCache("test", 2, WithPolicy(LFUPolicy))
+		require.NoError(t, err)
+
+		key := shard.KeyFromString("key")
+		ac, err := cache.GetOrLoad(ctx, key, func(ctx context.Context, key shard.Key) (Accessor, error) {
+			return &mockAccessor{data: []byte("test_data")}, nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, ac.Close())
+		for i := 0; i < 3; i++ {
+			ac, err = cache.Get(key)
+			require.NoError(t, err)
+			require.NoError(t, ac.Close())
+		}
+
+		stats := cache.Stats()
+		require.Equal(t, "test", stats.Name)
+		require.Equal(t, LFUPolicy, stats.Policy)
+		require.EqualValues(t, 3, stats.Hits)
+		require.EqualValues(t, 1, stats.Misses)
+		require.Equal(t, 0.75, stats.HitRatio)
+		require.Equal(t, []EntryStats{{Key: key.String(), Size: 9, Hits: 3}}, stats.Entries)
+	})
+
+	t.Run("expired by ttl", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		cache, err := NewAccessorCache("test", 1, WithPolicy(TTLPolicy), WithTTL(time.Millisecond*50))
+		require.NoError(t, err)
+
+		key := shard.KeyFromString("key")
+		mock := &mockAccessor{}
+		ac, err := cache.GetOrLoad(ctx, key, func(ctx context.Context, key shard.Key) (Accessor, error) {
+			return mock, nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, ac.Close())
+
+		time.Sleep(time.Millisecond * 100)
+		_, err = cache.Get(key)
+		require.ErrorIs(t, err, errCacheMiss)
+		mock.checkClosed(t, true)
+	})
 }
 
 type mockAccessor struct {
@@ -308,6 +385,12 @@ func (m *mockAccessor) Blockstore() (dagstore.ReadBlockstore, error) {
 	return rbsMock{}, nil
 }
 
+func (m *mockAccessor) Size() int64 {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return int64(len(m.data))
+}
+
 func (m *mockAccessor) Close() error {
 	m.m.Lock()
 	defer m.m.Unlock()
@@ -47,3 +47,34 @@ type Accessor interface {
 	Reader() io.Reader
 	io.Closer
 }
+
+// Sizer is implemented by the Accessors able to report their size in bytes, which the caches
+// limited by bytes account for.
+type Sizer interface {
+	Size() int64
+}
+
+// Stats describes the contents of a Cache and how well it serves the requests.
+type Stats struct {
+	Name   string     `json:"name"`
+	Policy PolicyType `json:"policy"`
+	// MaxEntries is the maximum amount of accessors in the cache.
+	MaxEntries int `json:"max_entries"`
+	// MaxBytes is the maximum total size of the accessors in the cache. Zero means no limit.
+	MaxBytes int64 `json:"max_bytes"`
+	// Bytes is the total size of the cached accessors.
+	Bytes    int64   `json:"bytes"`
+	Hits     uint64  `json:"hits"`
+	Misses   uint64  `json:"misses"`
+	HitRatio float64 `json:"hit_ratio"`
+	// Entries lists the cached accessors, the most requested first.
+	Entries []EntryStats `json:"entries"`
+}
+
+// EntryStats describes a cached accessor.
+type EntryStats struct {
+	Key  string `json:"key"`
+	Size int64  `json:"size"`
+	// Hits is the amount of requests served by the accessor since it was cached.
+	Hits uint64 `json:"hits"`
+}
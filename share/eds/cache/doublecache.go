@@ -43,6 +43,17 @@ func (mc *DoubleCache) Second() Cache {
 	return mc.second
 }
 
+// Stats returns the stats of the underlying caches able to report them.
+func (mc *DoubleCache) Stats() []Stats {
+	var stats []Stats
+	for _, c := range []Cache{mc.first, mc.second} {
+		if sc, ok := c.(interface{ Stats() Stats }); ok {
+			stats = append(stats, sc.Stats())
+		}
+	}
+	return stats
+}
+
 func (mc *DoubleCache) EnableMetrics() error {
 	if err := mc.first.EnableMetrics(); err != nil {
 		return err
@@ -39,11 +39,21 @@ func newMetrics(bc *AccessorCache) (*metrics, error) {
 		return nil, err
 	}
 
+	cacheBytes, err := meter.Int64ObservableGauge(metricsPrefix+"_bytes",
+		metric.WithDescription("total size of items in blockstore cache in bytes"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	callback := func(ctx context.Context, observer metric.Observer) error {
-		observer.ObserveInt64(cacheSize, int64(bc.cache.Len()))
+		observer.ObserveInt64(cacheSize, int64(bc.len()))
+		bc.lk.Lock()
+		observer.ObserveInt64(cacheBytes, bc.bytes)
+		bc.lk.Unlock()
 		return nil
 	}
-	_, err = meter.RegisterCallback(callback, cacheSize)
+	_, err = meter.RegisterCallback(callback, cacheSize, cacheBytes)
 
 	return &metrics{
 		getCounter:     getCounter,
@@ -0,0 +1,337 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"time"
+
+	"github.com/filecoin-project/dagstore/shard"
+)
+
+// PolicyType is the eviction policy of an AccessorCache.
+type PolicyType string
+
+const (
+	// LRUPolicy evicts the least recently used accessor.
+	LRUPolicy PolicyType = "lru"
+	// LFUPolicy evicts the least frequently used accessor, breaking ties by recency.
+	LFUPolicy PolicyType = "lfu"
+	// ARCPolicy balances between recently and frequently used accessors, adapting to the workload
+	// as the Adaptive Replacement Cache does.
+	ARCPolicy PolicyType = "arc"
+	// TTLPolicy expires accessors after a fixed time since they were cached and evicts the oldest
+	// accessor when the cache is full.
+	TTLPolicy PolicyType = "ttl"
+)
+
+// Validate checks that the PolicyType is known.
+func (tp PolicyType) Validate() error {
+	switch tp {
+	case LRUPolicy, LFUPolicy, ARCPolicy, TTLPolicy:
+		return nil
+	default:
+		return fmt.Errorf("unknown cache policy %q", tp)
+	}
+}
+
+// policy tracks the keys of an AccessorCache and decides which of them to evict. The weight of a
+// key is the size of its accessor if the cache is limited by bytes and one otherwise. Policies are
+// not safe for concurrent use.
+type policy interface {
+	// add starts tracking the key with the given weight.
+	add(key shard.Key, weight int64)
+	// touch records a hit of the tracked key.
+	touch(key shard.Key)
+	// remove stops tracking the key.
+	remove(key shard.Key)
+	// evict picks the key to evict next and stops tracking it.
+	evict() (shard.Key, bool)
+	// expired reports whether the tracked key must not be served anymore.
+	expired(key shard.Key) bool
+}
+
+func newPolicy(tp PolicyType, capacity int64, ttl time.Duration) policy {
+	switch tp {
+	case LFUPolicy:
+		return newLFUPolicy()
+	case ARCPolicy:
+		return newARCPolicy(capacity)
+	case TTLPolicy:
+		return newTTLPolicy(ttl)
+	default:
+		return newLRUPolicy()
+	}
+}
+
+// orderedKeys is a list of keys ordered from the most to the least recent, along with their
+// weights.
+type orderedKeys struct {
+	order  *list.List
+	items  map[shard.Key]*list.Element
+	weight int64
+}
+
+type weightedKey struct {
+	key    shard.Key
+	weight int64
+	added  time.Time
+}
+
+func newOrderedKeys() *orderedKeys {
+	return &orderedKeys{
+		order: list.New(),
+		items: make(map[shard.Key]*list.Element),
+	}
+}
+
+func (o *orderedKeys) has(key shard.Key) bool {
+	_, ok := o.items[key]
+	return ok
+}
+
+func (o *orderedKeys) len() int {
+	return len(o.items)
+}
+
+func (o *orderedKeys) pushFront(wk weightedKey) {
+	o.remove(wk.key)
+	o.items[wk.key] = o.order.PushFront(wk)
+	o.weight += wk.weight
+}
+
+func (o *orderedKeys) moveToFront(key shard.Key) {
+	if el, ok := o.items[key]; ok {
+		o.order.MoveToFront(el)
+	}
+}
+
+func (o *orderedKeys) get(key shard.Key) (weightedKey, bool) {
+	el, ok := o.items[key]
+	if !ok {
+		return weightedKey{}, false
+	}
+	return el.Value.(weightedKey), true
+}
+
+func (o *orderedKeys) remove(key shard.Key) (weightedKey, bool) {
+	el, ok := o.items[key]
+	if !ok {
+		return weightedKey{}, false
+	}
+	wk := o.order.Remove(el).(weightedKey)
+	delete(o.items, key)
+	o.weight -= wk.weight
+	return wk, true
+}
+
+func (o *orderedKeys) popBack() (weightedKey, bool) {
+	el := o.order.Back()
+	if el == nil {
+		return weightedKey{}, false
+	}
+	return o.remove(el.Value.(weightedKey).key)
+}
+
+// lruPolicy evicts the least recently used key.
+type lruPolicy struct {
+	keys *orderedKeys
+}
+
+func newLRUPolicy() *lruPolicy {
+	return &lruPolicy{keys: newOrderedKeys()}
+}
+
+func (p *lruPolicy) add(key shard.Key, weight int64) {
+	p.keys.pushFront(weightedKey{key: key, weight: weight})
+}
+
+func (p *lruPolicy) touch(key shard.Key) {
+	p.keys.moveToFront(key)
+}
+
+func (p *lruPolicy) remove(key shard.Key) {
+	p.keys.remove(key)
+}
+
+func (p *lruPolicy) evict() (shard.Key, bool) {
+	wk, ok := p.keys.popBack()
+	return wk.key, ok
+}
+
+func (p *lruPolicy) expired(shard.Key) bool {
+	return false
+}
+
+// lfuPolicy evicts the least frequently used key, and the least recently used one among the keys
+// used equally often. Finding the victim is linear, which is negligible next to opening an
+// accessor for the cache sizes in use.
+type lfuPolicy struct {
+	items map[shard.Key]*lfuEntry
+	// clock orders the uses of the keys
+	clock uint64
+}
+
+type lfuEntry struct {
+	hits     uint64
+	lastUsed uint64
+}
+
+func newLFUPolicy() *lfuPolicy {
+	return &lfuPolicy{items: make(map[shard.Key]*lfuEntry)}
+}
+
+func (p *lfuPolicy) add(key shard.Key, _ int64) {
+	p.clock++
+	p.items[key] = &lfuEntry{hits: 1, lastUsed: p.clock}
+}
+
+func (p *lfuPolicy) touch(key shard.Key) {
+	entry, ok := p.items[key]
+	if !ok {
+		return
+	}
+	p.clock++
+	entry.hits++
+	entry.lastUsed = p.clock
+}
+
+func (p *lfuPolicy) remove(key shard.Key) {
+	delete(p.items, key)
+}
+
+func (p *lfuPolicy) evict() (shard.Key, bool) {
+	var (
+		victim shard.Key
+		least  *lfuEntry
+	)
+	for key, entry := range p.items {
+		if least == nil || entry.hits < least.hits ||
+			(entry.hits == least.hits && entry.lastUsed < least.lastUsed) {
+			victim, least = key, entry
+		}
+	}
+	if least == nil {
+		return shard.Key{}, false
+	}
+	delete(p.items, victim)
+	return victim, true
+}
+
+func (p *lfuPolicy) expired(shard.Key) bool {
+	return false
+}
+
+// arcPolicy splits the cached keys between the ones used once recently (t1) and the ones used at
+// least twice (t2). It remembers the keys recently evicted from both (b1 and b2) and moves the
+// target weight of t1 towards the list whose evicted keys get requested again.
+type arcPolicy struct {
+	capacity int64
+	// target is the weight of t1 the policy aims at
+	target         int64
+	t1, t2, b1, b2 *orderedKeys
+}
+
+func newARCPolicy(capacity int64) *arcPolicy {
+	return &arcPolicy{
+		capacity: capacity,
+		t1:       newOrderedKeys(),
+		t2:       newOrderedKeys(),
+		b1:       newOrderedKeys(),
+		b2:       newOrderedKeys(),
+	}
+}
+
+func (p *arcPolicy) add(key shard.Key, weight int64) {
+	wk := weightedKey{key: key, weight: weight}
+	switch {
+	case p.b1.has(key):
+		// the key was evicted from t1 too early, so grow its target
+		p.target = min(p.capacity, p.target+weight*max(1, int64(p.b2.len()/p.b1.len())))
+		p.b1.remove(key)
+		p.t2.pushFront(wk)
+	case p.b2.has(key):
+		// the key was evicted from t2 too early, so shrink the target of t1
+		p.target = max(0, p.target-weight*max(1, int64(p.b1.len()/p.b2.len())))
+		p.b2.remove(key)
+		p.t2.pushFront(wk)
+	case p.t2.has(key):
+		p.t2.pushFront(wk)
+	case p.t1.has(key):
+		p.t1.remove(key)
+		p.t2.pushFront(wk)
+	default:
+		p.t1.pushFront(wk)
+	}
+}
+
+func (p *arcPolicy) touch(key shard.Key) {
+	if wk, ok := p.t1.remove(key); ok {
+		p.t2.pushFront(wk)
+		return
+	}
+	p.t2.moveToFront(key)
+}
+
+func (p *arcPolicy) remove(key shard.Key) {
+	p.t1.remove(key)
+	p.t2.remove(key)
+	p.b1.remove(key)
+	p.b2.remove(key)
+}
+
+func (p *arcPolicy) evict() (shard.Key, bool) {
+	from, ghosts := p.t2, p.b2
+	if p.t1.len() > 0 && (p.t1.weight > p.target || p.t2.len() == 0) {
+		from, ghosts = p.t1, p.b1
+	}
+
+	wk, ok := from.popBack()
+	if !ok {
+		return shard.Key{}, false
+	}
+	ghosts.pushFront(wk)
+	// remember at most as many evicted keys as fit into the cache
+	for ghosts.weight > p.capacity {
+		ghosts.popBack()
+	}
+	return wk.key, true
+}
+
+func (p *arcPolicy) expired(shard.Key) bool {
+	return false
+}
+
+// ttlPolicy expires keys after the ttl since they were added and evicts the oldest key first.
+type ttlPolicy struct {
+	ttl  time.Duration
+	keys *orderedKeys
+	now  func() time.Time
+}
+
+func newTTLPolicy(ttl time.Duration) *ttlPolicy {
+	return &ttlPolicy{
+		ttl:  ttl,
+		keys: newOrderedKeys(),
+		now:  time.Now,
+	}
+}
+
+func (p *ttlPolicy) add(key shard.Key, weight int64) {
+	p.keys.pushFront(weightedKey{key: key, weight: weight, added: p.now()})
+}
+
+func (p *ttlPolicy) touch(shard.Key) {}
+
+func (p *ttlPolicy) remove(key shard.Key) {
+	p.keys.remove(key)
+}
+
+func (p *ttlPolicy) evict() (shard.Key, bool) {
+	wk, ok := p.keys.popBack()
+	return wk.key, ok
+}
+
+func (p *ttlPolicy) expired(key shard.Key) bool {
+	wk, ok := p.keys.get(key)
+	return ok && p.now().Sub(wk.added) >= p.ttl
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/dagstore/shard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	k1, k2, k3 := shard.KeyFromString("k1"), shard.KeyFromString("k2"), shard.KeyFromString("k3")
+
+	evictAll := func(p policy) []shard.Key {
+		var evicted []shard.Key
+		for {
+			key, ok := p.evict()
+			if !ok {
+				return evicted
+			}
+			evicted = append(evicted, key)
+		}
+	}
+
+	t.Run("lru", func(t *testing.T) {
+		p := newLRUPolicy()
+		p.add(k1, 1)
+		p.add(k2, 1)
+		p.add(k3, 1)
+		p.touch(k1)
+		p.remove(k3)
+		require.Equal(t, []shard.Key{k2, k1}, evictAll(p))
+	})
+
+	t.Run("lfu", func(t *testing.T) {
+		p := newLFUPolicy()
+		p.add(k1, 1)
+		p.add(k2, 1)
+		p.add(k3, 1)
+		p.touch(k1)
+		p.touch(k1)
+		p.touch(k3)
+		require.Equal(t, []shard.Key{k2, k3, k1}, evictAll(p))
+	})
+
+	t.Run("arc", func(t *testing.T) {
+		p := newARCPolicy(2)
+		p.add(k1, 1)
+		p.touch(k1)
+		p.add(k2, 1)
+		p.add(k3, 1)
+		// the keys used once are evicted before the frequently used ones
+		key, ok := p.evict()
+		require.True(t, ok)
+		require.Equal(t, k2, key)
+		require.True(t, p.b1.has(k2))
+
+		// the evicted key returns, so the keys used once get more room
+		p.add(k2, 1)
+		require.EqualValues(t, 1, p.target)
+		require.True(t, p.t2.has(k2))
+		// k3 fits into the target, so the least recent of the frequently used keys goes first
+		require.Equal(t, []shard.Key{k1, k2, k3}, evictAll(p))
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		now := time.Now()
+		p := newTTLPolicy(time.Minute)
+		p.now = func() time.Time { return now }
+		p.add(k1, 1)
+		now = now.Add(time.Second * 30)
+		p.add(k2, 1)
+		p.touch(k1)
+		require.False(t, p.expired(k1))
+
+		now = now.Add(time.Second * 30)
+		require.True(t, p.expired(k1))
+		require.False(t, p.expired(k2))
+		require.Equal(t, []shard.Key{k1, k2}, evictAll(p))
+	})
+}
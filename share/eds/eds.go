@@ -71,22 +71,30 @@ func writeQuadrants(eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
 	hasher := nmt.NewNmtHasher(sha256.New(), share.NamespaceSize, ipld.NMTIgnoreMaxNamespace)
 	shares := quadrantOrder(eds)
 	for _, share := range shares {
-		leaf, err := hasher.HashLeaf(share)
-		if err != nil {
-			return fmt.Errorf("hashing share: %w", err)
-		}
-		cid, err := ipld.CidFromNamespacedSha256(leaf)
-		if err != nil {
-			return fmt.Errorf("getting cid from share: %w", err)
-		}
-		err = util.LdWrite(w, cid.Bytes(), share)
-		if err != nil {
-			return fmt.Errorf("writing share to file: %w", err)
+		if err := writeLeaf(hasher, share, w); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// writeLeaf writes the namespaced share to the CARv1 file as a leaf block.
+func writeLeaf(hasher *nmt.NmtHasher, share []byte, w io.Writer) error {
+	leaf, err := hasher.HashLeaf(share)
+	if err != nil {
+		return fmt.Errorf("hashing share: %w", err)
+	}
+	cid, err := ipld.CidFromNamespacedSha256(leaf)
+	if err != nil {
+		return fmt.Errorf("getting cid from share: %w", err)
+	}
+	err = util.LdWrite(w, cid.Bytes(), share)
+	if err != nil {
+		return fmt.Errorf("writing share to file: %w", err)
+	}
+	return nil
+}
+
 // writeProofs iterates over the in-memory blockstore's keys and writes all inner nodes to the
 // CARv1 file.
 func writeProofs(ctx context.Context, eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
@@ -198,11 +206,15 @@ func rootsToCids(eds *rsmt2d.ExtendedDataSquare) ([]cid.Cid, error) {
 	if err != nil {
 		return nil, err
 	}
+	return axisRootsToCids(rowRoots, colRoots)
+}
 
+// axisRootsToCids converts the Row and Column roots to CIDs.
+func axisRootsToCids(rowRoots, colRoots [][]byte) (rootCids []cid.Cid, err error) {
 	roots := make([][]byte, 0, len(rowRoots)+len(colRoots))
 	roots = append(roots, rowRoots...)
 	roots = append(roots, colRoots...)
-	rootCids := make([]cid.Cid, len(roots))
+	rootCids = make([]cid.Cid, len(roots))
 	for i, r := range roots {
 		rootCids[i], err = ipld.CidFromNamespacedSha256(r)
 		if err != nil {
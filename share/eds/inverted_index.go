@@ -2,8 +2,13 @@ package eds
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"sync"
 
 	"github.com/filecoin-project/dagstore/index"
 	"github.com/filecoin-project/dagstore/shard"
@@ -11,23 +16,80 @@ import (
 	"github.com/multiformats/go-multihash"
 
 	dsbadger "github.com/celestiaorg/go-ds-badger4"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+const (
+	invertedIndexPath = "/inverted_index_v2/"
+
+	// mhKeySize is the amount of trailing bytes of a multihash the index is keyed by. The trailing
+	// bytes of NMT node hashes are the SHA256 digest, while the leading ones are namespaces, which are
+	// shared by many nodes.
+	mhKeySize = 16
 )
 
-const invertedIndexPath = "/inverted_index/"
+var (
+	mhPrefix    = ds.NewKey("mh")
+	shardPrefix = ds.NewKey("shard")
+	idPrefix    = ds.NewKey("id")
+	lastIDKey   = ds.NewKey("last_id")
+	// lastMigratedKey keeps the DataHash of the last EDS added by the migration of the legacy index
+	lastMigratedKey = ds.NewKey("last_migrated")
+)
 
 // ErrNotFoundInIndex is returned instead of ErrNotFound if the multihash doesn't exist in the index
 var ErrNotFoundInIndex = fmt.Errorf("does not exist in index")
 
-// simpleInvertedIndex is an inverted index that only stores a single shard key per multihash. Its
-// implementation is modified from the default upstream implementation in dagstore/index.
-type simpleInvertedIndex struct {
-	ds ds.Batching
+// invertedIndex maps multihashes to the shards containing them. Compared to the
+// simpleInvertedIndex, it keys entries by a truncated multihash and points them to a numeric id of
+// the shard rather than its key, which is resolved through a small per-shard table. Only a single
+// shard is stored per multihash, as any of the shards containing it can serve it.
+//
+// The amount of entries is not reduced: every multihash still has its own entry, as the blockstore
+// is requested nodes by their CIDs alone, which can't be traced back to the row or the square
+// containing them, so neither per-square key ranges nor row roots can serve the lookups. Only the
+// entries are smaller, which about halves the index on disk, as measured by BenchmarkInvertedIndex.
+//
+// Stores created before the invertedIndex keep the simpleInvertedIndex as a legacy index, which
+// serves lookups missing in the invertedIndex until the Store migrates all the shards.
+type invertedIndex struct {
+	ds   ds.Batching
+	path string
+
+	// idLk guards the allocation of shard ids
+	idLk sync.Mutex
+	// keys caches the shard keys by their ids
+	keys sync.Map
+
+	legacyLk sync.RWMutex
+	legacy   *simpleInvertedIndex
+}
+
+// newInvertedIndex opens the inverted index of the store under the given path along with the
+// legacy index, if one exists.
+func newInvertedIndex(storePath string) (*invertedIndex, error) {
+	opts := invertedIndexOptions()
+	ds, err := dsbadger.NewDatastore(storePath+invertedIndexPath, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("can't open Badger Datastore: %w", err)
+	}
+
+	idx := &invertedIndex{ds: ds, path: storePath}
+	_, err = os.Stat(storePath + legacyInvertedIndexPath)
+	switch {
+	case err == nil:
+		idx.legacy, err = newSimpleInvertedIndex(storePath)
+		if err != nil {
+			return nil, errors.Join(err, ds.Close())
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, errors.Join(err, ds.Close())
+	}
+	return idx, nil
 }
 
-// newSimpleInvertedIndex returns a new inverted index that only stores a single shard key per
-// multihash. This is because we use badger as a storage backend, so updates are expensive, and we
-// don't care which shard is used to serve a cid.
-func newSimpleInvertedIndex(storePath string) (*simpleInvertedIndex, error) {
+func invertedIndexOptions() dsbadger.Options {
 	opts := dsbadger.DefaultOptions // this should be copied
 	// turn off value log GC
 	opts.GcInterval = 0
@@ -37,55 +99,296 @@ func newSimpleInvertedIndex(storePath string) (*simpleInvertedIndex, error) {
 	opts.NumLevelZeroTables = 1
 	// MaxLevels = 8 will allow the db to grow to ~11.1 TiB
 	opts.MaxLevels = 8
+	return opts
+}
 
-	ds, err := dsbadger.NewDatastore(storePath+invertedIndexPath, &opts)
+// AddMultihashesForShard points all the given multihashes to the shard in a single batch,
+// overwriting the entries of other shards.
+func (s *invertedIndex) AddMultihashesForShard(
+	ctx context.Context,
+	mhIter index.MultihashIterator,
+	sk shard.Key,
+) error {
+	id, err := s.shardID(ctx, sk, true)
 	if err != nil {
-		return nil, fmt.Errorf("can't open Badger Datastore: %w", err)
+		return err
 	}
 
-	return &simpleInvertedIndex{ds: ds}, nil
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ds batch: %w", err)
+	}
+
+	value := encodeShardID(id)
+	err = mhIter.ForEach(func(mh multihash.Multihash) error {
+		if err := batch.Put(ctx, mhKey(mh), value); err != nil {
+			return fmt.Errorf("failed to put mh=%s, err=%w", mh, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add index entry: %w", err)
+	}
+
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return nil
 }
 
-func (s *simpleInvertedIndex) AddMultihashesForShard(
+// dropMultihashesForShard removes the entries of all the given multihashes that point to the given
+// shard along with the id of the shard. Entries that were overwritten by another shard are kept,
+// as that shard still serves them.
+func (s *invertedIndex) dropMultihashesForShard(
 	ctx context.Context,
 	mhIter index.MultihashIterator,
 	sk shard.Key,
 ) error {
-	// in the original implementation, a mutex is used here to prevent unnecessary updates to the
-	// key. The amount of extra data produced by this is negligible, and the performance benefits
-	// from removing the lock are significant (indexing is a hot path during sync).
+	s.legacyLk.RLock()
+	defer s.legacyLk.RUnlock()
+	if s.legacy != nil {
+		// keep the legacy index in sync, as it still serves lookups
+		if err := s.legacy.dropMultihashesForShard(ctx, mhIter, sk); err != nil {
+			return fmt.Errorf("legacy index: %w", err)
+		}
+	}
+
+	id, err := s.shardID(ctx, sk, false)
+	if errors.Is(err, ErrNotFoundInIndex) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
 	batch, err := s.ds.Batch(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create ds batch: %w", err)
 	}
 
 	err = mhIter.ForEach(func(mh multihash.Multihash) error {
-		key := ds.NewKey(string(mh))
-		if err := batch.Put(ctx, key, []byte(sk.String())); err != nil {
-			return fmt.Errorf("failed to put mh=%s, err=%w", mh, err)
+		key := mhKey(mh)
+		value, err := s.ds.Get(ctx, key)
+		switch {
+		case errors.Is(err, ds.ErrNotFound):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to get mh=%s, err=%w", mh, err)
+		}
+		if entryID, err := decodeShardID(value); err != nil || entryID != id {
+			return nil
+		}
+
+		if err := batch.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete mh=%s, err=%w", mh, err)
 		}
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to add index entry: %w", err)
+		return fmt.Errorf("failed to drop index entry: %w", err)
 	}
 
+	// the entries pointing to the id are gone, so the id is not needed anymore
+	if err := batch.Delete(ctx, shardPrefix.ChildString(sk.String())); err != nil {
+		return fmt.Errorf("failed to delete shard id: %w", err)
+	}
+	if err := batch.Delete(ctx, idKey(id)); err != nil {
+		return fmt.Errorf("failed to delete shard key: %w", err)
+	}
 	if err := batch.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit batch: %w", err)
 	}
+	s.keys.Delete(id)
 	return nil
 }
 
-func (s *simpleInvertedIndex) GetShardsForMultihash(ctx context.Context, mh multihash.Multihash) ([]shard.Key, error) {
-	key := ds.NewKey(string(mh))
-	sbz, err := s.ds.Get(ctx, key)
+func (s *invertedIndex) GetShardsForMultihash(ctx context.Context, mh multihash.Multihash) ([]shard.Key, error) {
+	value, err := s.ds.Get(ctx, mhKey(mh))
+	if errors.Is(err, ds.ErrNotFound) {
+		s.legacyLk.RLock()
+		defer s.legacyLk.RUnlock()
+		if s.legacy != nil {
+			return s.legacy.GetShardsForMultihash(ctx, mh)
+		}
+	}
 	if err != nil {
 		return nil, errors.Join(ErrNotFoundInIndex, err)
 	}
 
-	return []shard.Key{shard.KeyFromString(string(sbz))}, nil
+	id, err := decodeShardID(value)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := s.shardKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return []shard.Key{sk}, nil
+}
+
+// shardID returns the id of the shard, allocating a new one if it's missing and create is set.
+func (s *invertedIndex) shardID(ctx context.Context, sk shard.Key, create bool) (uint32, error) {
+	s.idLk.Lock()
+	defer s.idLk.Unlock()
+
+	key := shardPrefix.ChildString(sk.String())
+	value, err := s.ds.Get(ctx, key)
+	switch {
+	case err == nil:
+		return decodeShardID(value)
+	case !errors.Is(err, ds.ErrNotFound):
+		return 0, fmt.Errorf("failed to get shard id: %w", err)
+	case !create:
+		return 0, errors.Join(ErrNotFoundInIndex, err)
+	}
+
+	var id uint32
+	value, err = s.ds.Get(ctx, lastIDKey)
+	switch {
+	case err == nil:
+		id, err = decodeShardID(value)
+		if err != nil {
+			return 0, err
+		}
+		id++
+	case !errors.Is(err, ds.ErrNotFound):
+		return 0, fmt.Errorf("failed to get last shard id: %w", err)
+	}
+
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create ds batch: %w", err)
+	}
+	value = encodeShardID(id)
+	if err := batch.Put(ctx, lastIDKey, value); err != nil {
+		return 0, fmt.Errorf("failed to put last shard id: %w", err)
+	}
+	if err := batch.Put(ctx, key, value); err != nil {
+		return 0, fmt.Errorf("failed to put shard id: %w", err)
+	}
+	if err := batch.Put(ctx, idKey(id), []byte(sk.String())); err != nil {
+		return 0, fmt.Errorf("failed to put shard key: %w", err)
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit batch: %w", err)
+	}
+	s.keys.Store(id, sk)
+	return id, nil
+}
+
+// shardKey resolves the key of the shard by its id.
+func (s *invertedIndex) shardKey(ctx context.Context, id uint32) (shard.Key, error) {
+	if sk, ok := s.keys.Load(id); ok {
+		return sk.(shard.Key), nil
+	}
+
+	value, err := s.ds.Get(ctx, idKey(id))
+	if err != nil {
+		// the shard could be dropped after the entry was read
+		return shard.Key{}, errors.Join(ErrNotFoundInIndex, err)
+	}
+	sk := shard.KeyFromString(string(value))
+	s.keys.Store(id, sk)
+	return sk, nil
+}
+
+// migrating reports whether the legacy index is still in use.
+func (s *invertedIndex) migrating() bool {
+	s.legacyLk.RLock()
+	defer s.legacyLk.RUnlock()
+	return s.legacy != nil
+}
+
+// lastMigrated returns the DataHash of the last EDS added to the index by the migration of the
+// legacy index or nil if the migration hasn't started yet.
+func (s *invertedIndex) lastMigrated(ctx context.Context) (share.DataHash, error) {
+	value, err := s.ds.Get(ctx, lastMigratedKey)
+	switch {
+	case errors.Is(err, ds.ErrNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get last migrated: %w", err)
+	}
+	return value, nil
+}
+
+// setLastMigrated persists the DataHash of the last EDS added to the index by the migration of the
+// legacy index.
+func (s *invertedIndex) setLastMigrated(ctx context.Context, root share.DataHash) error {
+	if err := s.ds.Put(ctx, lastMigratedKey, root); err != nil {
+		return fmt.Errorf("failed to put last migrated: %w", err)
+	}
+	return nil
 }
 
-func (s *simpleInvertedIndex) close() error {
+// dropLegacy closes and removes the legacy index once all the shards are migrated.
+func (s *invertedIndex) dropLegacy(ctx context.Context) error {
+	s.legacyLk.Lock()
+	defer s.legacyLk.Unlock()
+	if s.legacy == nil {
+		return nil
+	}
+
+	if err := s.legacy.close(); err != nil {
+		return err
+	}
+	s.legacy = nil
+	if err := os.RemoveAll(s.path + legacyInvertedIndexPath); err != nil {
+		return err
+	}
+	// the progress is only needed while the legacy index exists
+	if err := s.ds.Delete(ctx, lastMigratedKey); err != nil {
+		return fmt.Errorf("failed to delete last migrated: %w", err)
+	}
+	return nil
+}
+
+func (s *invertedIndex) close() error {
+	s.legacyLk.Lock()
+	defer s.legacyLk.Unlock()
+	if s.legacy != nil {
+		if err := s.legacy.close(); err != nil {
+			return err
+		}
+	}
 	return s.ds.Close()
 }
+
+// mhKey returns the key of the entry of the given multihash.
+func mhKey(mh multihash.Multihash) ds.Key {
+	if len(mh) > mhKeySize {
+		mh = mh[len(mh)-mhKeySize:]
+	}
+	return mhPrefix.ChildString(base64.RawURLEncoding.EncodeToString(mh))
+}
+
+func idKey(id uint32) ds.Key {
+	return idPrefix.ChildString(strconv.FormatUint(uint64(id), 36))
+}
+
+func encodeShardID(id uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, id)
+}
+
+func decodeShardID(value []byte) (uint32, error) {
+	if len(value) != 4 {
+		return 0, fmt.Errorf("invalid shard id length: %d", len(value))
+	}
+	return binary.BigEndian.Uint32(value), nil
+}
+
+// iterableIndex is implemented by the full CAR indexes kept in the index repository.
+type iterableIndex interface {
+	ForEach(func(mh multihash.Multihash, offset uint64) error) error
+}
+
+// mhIterator adapts an iterableIndex to the index.MultihashIterator used by the inverted index.
+type mhIterator struct {
+	idx iterableIndex
+}
+
+func (it *mhIterator) ForEach(fn func(mh multihash.Multihash) error) error {
+	return it.idx.ForEach(func(mh multihash.Multihash, _ uint64) error {
+		return fn(mh)
+	})
+}
@@ -0,0 +1,121 @@
+package eds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filecoin-project/dagstore/index"
+	"github.com/filecoin-project/dagstore/shard"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/multiformats/go-multihash"
+
+	dsbadger "github.com/celestiaorg/go-ds-badger4"
+)
+
+const legacyInvertedIndexPath = "/inverted_index/"
+
+// simpleInvertedIndex is an inverted index that only stores a single shard key per multihash. Its
+// implementation is modified from the default upstream implementation in dagstore/index.
+//
+// It is superseded by the invertedIndex and is only kept to serve lookups of existing stores until
+// they are migrated.
+type simpleInvertedIndex struct {
+	ds ds.Batching
+}
+
+// newSimpleInvertedIndex returns a new inverted index that only stores a single shard key per
+// multihash. This is because we use badger as a storage backend, so updates are expensive, and we
+// don't care which shard is used to serve a cid.
+func newSimpleInvertedIndex(storePath string) (*simpleInvertedIndex, error) {
+	opts := invertedIndexOptions()
+	ds, err := dsbadger.NewDatastore(storePath+legacyInvertedIndexPath, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("can't open Badger Datastore: %w", err)
+	}
+
+	return &simpleInvertedIndex{ds: ds}, nil
+}
+
+func (s *simpleInvertedIndex) AddMultihashesForShard(
+	ctx context.Context,
+	mhIter index.MultihashIterator,
+	sk shard.Key,
+) error {
+	// in the original implementation, a mutex is used here to prevent unnecessary updates to the
+	// key. The amount of extra data produced by this is negligible, and the performance benefits
+	// from removing the lock are significant (indexing is a hot path during sync).
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ds batch: %w", err)
+	}
+
+	err = mhIter.ForEach(func(mh multihash.Multihash) error {
+		key := ds.NewKey(string(mh))
+		if err := batch.Put(ctx, key, []byte(sk.String())); err != nil {
+			return fmt.Errorf("failed to put mh=%s, err=%w", mh, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add index entry: %w", err)
+	}
+
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return nil
+}
+
+// dropMultihashesForShard removes the entries of all the given multihashes that point to the given
+// shard. Entries that were overwritten by another shard are kept, as that shard still serves them.
+func (s *simpleInvertedIndex) dropMultihashesForShard(
+	ctx context.Context,
+	mhIter index.MultihashIterator,
+	sk shard.Key,
+) error {
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ds batch: %w", err)
+	}
+
+	err = mhIter.ForEach(func(mh multihash.Multihash) error {
+		key := ds.NewKey(string(mh))
+		sbz, err := s.ds.Get(ctx, key)
+		switch {
+		case errors.Is(err, ds.ErrNotFound):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to get mh=%s, err=%w", mh, err)
+		case string(sbz) != sk.String():
+			return nil
+		}
+
+		if err := batch.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete mh=%s, err=%w", mh, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to drop index entry: %w", err)
+	}
+
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return nil
+}
+
+func (s *simpleInvertedIndex) GetShardsForMultihash(ctx context.Context, mh multihash.Multihash) ([]shard.Key, error) {
+	key := ds.NewKey(string(mh))
+	sbz, err := s.ds.Get(ctx, key)
+	if err != nil {
+		return nil, errors.Join(ErrNotFoundInIndex, err)
+	}
+
+	return []shard.Key{shard.KeyFromString(string(sbz))}, nil
+}
+
+func (s *simpleInvertedIndex) close() error {
+	return s.ds.Close()
+}
@@ -2,11 +2,19 @@ package eds
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/filecoin-project/dagstore/index"
 	"github.com/filecoin-project/dagstore/shard"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	"github.com/multiformats/go-multihash"
 	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 )
 
 type mockIterator struct {
@@ -53,3 +61,210 @@ func TestMultihashesForShard(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []shard.Key{shard.KeyFromString("shard2")}, shardKeys)
 }
+
+// TestDropMultihashesForShard ensures that only entries pointing to the dropped shard are removed
+// from the inverted index
+func TestDropMultihashesForShard(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	mhs := []multihash.Multihash{
+		multihash.Multihash("mh1"),
+		multihash.Multihash("mh2"),
+	}
+
+	invertedIndex, err := newSimpleInvertedIndex(t.TempDir())
+	require.NoError(t, err)
+
+	err = invertedIndex.AddMultihashesForShard(ctx, &mockIterator{mhs: mhs}, shard.KeyFromString("shard1"))
+	require.NoError(t, err)
+	// mh2 is now served by shard2
+	err = invertedIndex.AddMultihashesForShard(ctx, &mockIterator{mhs: mhs[1:]}, shard.KeyFromString("shard2"))
+	require.NoError(t, err)
+
+	err = invertedIndex.dropMultihashesForShard(ctx, &mockIterator{mhs: mhs}, shard.KeyFromString("shard1"))
+	require.NoError(t, err)
+
+	_, err = invertedIndex.GetShardsForMultihash(ctx, mhs[0])
+	require.ErrorIs(t, err, ErrNotFoundInIndex)
+	shardKeys, err := invertedIndex.GetShardsForMultihash(ctx, mhs[1])
+	require.NoError(t, err)
+	require.Equal(t, []shard.Key{shard.KeyFromString("shard2")}, shardKeys)
+}
+
+// TestInvertedIndex ensures that the compact inverted index stores a single shard per duplicate
+// multihash and drops only the entries of the dropped shard
+func TestInvertedIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	mhs := []multihash.Multihash{
+		multihash.Multihash("mh1"),
+		multihash.Multihash("mh2"),
+		multihash.Multihash("mh3"),
+	}
+	shard1, shard2 := shard.KeyFromString("shard1"), shard.KeyFromString("shard2")
+
+	invertedIndex, err := newInvertedIndex(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, invertedIndex.close())
+	})
+
+	err = invertedIndex.AddMultihashesForShard(ctx, &mockIterator{mhs: mhs}, shard1)
+	require.NoError(t, err)
+	shardKeys, err := invertedIndex.GetShardsForMultihash(ctx, mhs[0])
+	require.NoError(t, err)
+	require.Equal(t, []shard.Key{shard1}, shardKeys)
+
+	// mh2 is now served by shard2
+	err = invertedIndex.AddMultihashesForShard(ctx, &mockIterator{mhs: mhs[1:2]}, shard2)
+	require.NoError(t, err)
+	shardKeys, err = invertedIndex.GetShardsForMultihash(ctx, mhs[1])
+	require.NoError(t, err)
+	require.Equal(t, []shard.Key{shard2}, shardKeys)
+
+	err = invertedIndex.dropMultihashesForShard(ctx, &mockIterator{mhs: mhs}, shard1)
+	require.NoError(t, err)
+	for _, mh := range []multihash.Multihash{mhs[0], mhs[2]} {
+		_, err = invertedIndex.GetShardsForMultihash(ctx, mh)
+		require.ErrorIs(t, err, ErrNotFoundInIndex)
+	}
+	shardKeys, err = invertedIndex.GetShardsForMultihash(ctx, mhs[1])
+	require.NoError(t, err)
+	require.Equal(t, []shard.Key{shard2}, shardKeys)
+
+	// the dropped shard gets a new id once it's added again
+	err = invertedIndex.AddMultihashesForShard(ctx, &mockIterator{mhs: mhs[:1]}, shard1)
+	require.NoError(t, err)
+	shardKeys, err = invertedIndex.GetShardsForMultihash(ctx, mhs[0])
+	require.NoError(t, err)
+	require.Equal(t, []shard.Key{shard1}, shardKeys)
+}
+
+// TestInvertedIndex_Legacy ensures that lookups fall back to the legacy index until it is dropped
+func TestInvertedIndex_Legacy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	mhs := []multihash.Multihash{
+		multihash.Multihash("mh1"),
+		multihash.Multihash("mh2"),
+	}
+	shard1 := shard.KeyFromString("shard1")
+
+	path := t.TempDir()
+	legacy, err := newSimpleInvertedIndex(path)
+	require.NoError(t, err)
+	err = legacy.AddMultihashesForShard(ctx, &mockIterator{mhs: mhs}, shard1)
+	require.NoError(t, err)
+	require.NoError(t, legacy.close())
+
+	invertedIndex, err := newInvertedIndex(path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, invertedIndex.close())
+	})
+	require.True(t, invertedIndex.migrating())
+
+	shardKeys, err := invertedIndex.GetShardsForMultihash(ctx, mhs[0])
+	require.NoError(t, err)
+	require.Equal(t, []shard.Key{shard1}, shardKeys)
+
+	// drops are applied to the legacy index as well
+	err = invertedIndex.dropMultihashesForShard(ctx, &mockIterator{mhs: mhs[1:]}, shard1)
+	require.NoError(t, err)
+	_, err = invertedIndex.GetShardsForMultihash(ctx, mhs[1])
+	require.ErrorIs(t, err, ErrNotFoundInIndex)
+
+	err = invertedIndex.AddMultihashesForShard(ctx, &mockIterator{mhs: mhs[:1]}, shard1)
+	require.NoError(t, err)
+	require.NoError(t, invertedIndex.dropLegacy(ctx))
+	require.False(t, invertedIndex.migrating())
+	require.NoDirExists(t, path+legacyInvertedIndexPath)
+
+	shardKeys, err = invertedIndex.GetShardsForMultihash(ctx, mhs[0])
+	require.NoError(t, err)
+	require.Equal(t, []shard.Key{shard1}, shardKeys)
+}
+
+// BenchmarkInvertedIndex measures the time to index the squares and the size of the index on disk
+// per square for the legacy and the compact layouts.
+func BenchmarkInvertedIndex(b *testing.B) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.Cleanup(cancel)
+
+	const squares, odsWidth = 8, 32
+	edsStore, err := NewStore(DefaultParameters(), b.TempDir(), ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(b, err)
+	require.NoError(b, edsStore.Start(ctx))
+	b.Cleanup(func() {
+		require.NoError(b, edsStore.Stop(ctx))
+	})
+
+	keys := make([]shard.Key, squares)
+	iters := make([]*mhIterator, squares)
+	for i := range keys {
+		eds := edstest.RandEDS(b, odsWidth)
+		dah, err := share.NewRoot(eds)
+		require.NoError(b, err)
+		require.NoError(b, edsStore.Put(ctx, dah.Hash(), eds))
+		keys[i] = shard.KeyFromString(dah.String())
+		iters[i], err = edsStore.fullIndexIterator(keys[i])
+		require.NoError(b, err)
+	}
+
+	type shardIndex interface {
+		AddMultihashesForShard(context.Context, index.MultihashIterator, shard.Key) error
+		close() error
+	}
+	layouts := []struct {
+		name string
+		path string
+		open func(string) (shardIndex, error)
+	}{
+		{"legacy", legacyInvertedIndexPath, func(path string) (shardIndex, error) {
+			return newSimpleInvertedIndex(path)
+		}},
+		{"compact", invertedIndexPath, func(path string) (shardIndex, error) {
+			return newInvertedIndex(path)
+		}},
+	}
+	for _, layout := range layouts {
+		b.Run(layout.name, func(b *testing.B) {
+			var size int64
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				path := b.TempDir()
+				idx, err := layout.open(path)
+				require.NoError(b, err)
+				b.StartTimer()
+
+				for j := range keys {
+					err = idx.AddMultihashesForShard(ctx, iters[j], keys[j])
+					require.NoError(b, err)
+				}
+
+				b.StopTimer()
+				// closing flushes the entries to the tables on disk
+				require.NoError(b, idx.close())
+				size = tablesSize(b, path+layout.path)
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(size)/squares, "bytes/square")
+		})
+	}
+}
+
+// tablesSize returns the size of the tables of the Badger datastore under the given path.
+func tablesSize(b *testing.B, path string) int64 {
+	var size int64
+	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err == nil && filepath.Ext(path) == ".sst" {
+			size += info.Size()
+		}
+		return err
+	})
+	require.NoError(b, err)
+	return size
+}
@@ -2,6 +2,7 @@ package eds
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -25,6 +26,8 @@ const (
 	longOpFailed     longOpResult = "failed"
 
 	dagstoreShardStatusKey = "shard_status"
+
+	tierKey = "tier"
 )
 
 var (
@@ -49,6 +52,9 @@ type metrics struct {
 
 	longOpTime metric.Float64Histogram
 	gcTime     metric.Float64Histogram
+
+	tierAccessCount metric.Int64Counter
+	coldMoveCount   metric.Int64Counter
 }
 
 func (s *Store) WithMetrics() error {
@@ -118,6 +124,18 @@ func (s *Store) WithMetrics() error {
 		return err
 	}
 
+	tierAccessCount, err := meter.Int64Counter("eds_store_tier_access_counter",
+		metric.WithDescription("eds store amount of EDS reads served by each storage tier"))
+	if err != nil {
+		return err
+	}
+
+	coldMoveCount, err := meter.Int64Counter("eds_store_cold_move_counter",
+		metric.WithDescription("eds store amount of EDSes moved to the cold storage tier"))
+	if err != nil {
+		return err
+	}
+
 	dagStoreShards, err := meter.Int64ObservableGauge("eds_store_dagstore_shards",
 		metric.WithDescription("dagstore amount of shards by status"))
 	if err != nil {
@@ -155,6 +173,8 @@ func (s *Store) WithMetrics() error {
 		shardFailureCount:    shardFailureCount,
 		longOpTime:           longOpTime,
 		gcTime:               gcTime,
+		tierAccessCount:      tierAccessCount,
+		coldMoveCount:        coldMoveCount,
 	}
 	return nil
 }
@@ -170,6 +190,30 @@ func (m *metrics) observeGCtime(ctx context.Context, dur time.Duration, failed b
 		attribute.Bool(failedKey, failed)))
 }
 
+func (m *metrics) observeTierAccess(ctx context.Context, opName string, tier string) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	m.tierAccessCount.Add(ctx, 1, metric.WithAttributes(
+		attribute.String(opNameKey, opName),
+		attribute.String(tierKey, tier)))
+}
+
+func (m *metrics) observeColdMove(ctx context.Context) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	m.coldMoveCount.Add(ctx, 1)
+}
+
 func (m *metrics) observeShardFailure(ctx context.Context, shardKey string) {
 	if m == nil {
 		return
@@ -290,3 +334,108 @@ func (m *metrics) observeList(ctx context.Context, dur time.Duration, failed boo
 	m.listTime.Record(ctx, dur.Seconds(), metric.WithAttributes(
 		attribute.Bool(failedKey, failed)))
 }
+
+type prunerMetrics struct {
+	prunedCount metric.Int64Counter
+	lastPruned  atomic.Uint64
+}
+
+// WithMetrics enables metrics for the Pruner.
+func (p *Pruner) WithMetrics() error {
+	prunedCount, err := meter.Int64Counter("eds_store_pruned_counter",
+		metric.WithDescription("eds store amount of pruned EDSes"))
+	if err != nil {
+		return err
+	}
+
+	lastPrunedHeight, err := meter.Int64ObservableGauge("eds_store_last_pruned_height",
+		metric.WithDescription("eds store height of the last pruned EDS"))
+	if err != nil {
+		return err
+	}
+
+	m := &prunerMetrics{
+		prunedCount: prunedCount,
+	}
+
+	callback := func(ctx context.Context, observer metric.Observer) error {
+		observer.ObserveInt64(lastPrunedHeight, int64(m.lastPruned.Load()))
+		return nil
+	}
+
+	if _, err := meter.RegisterCallback(callback, lastPrunedHeight); err != nil {
+		return err
+	}
+
+	p.metrics = m
+	return nil
+}
+
+func (m *prunerMetrics) observePruned(ctx context.Context, height uint64) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	m.prunedCount.Add(ctx, 1)
+	m.lastPruned.Store(height)
+}
+
+const (
+	corruptionKey  = "corruption"
+	quarantinedKey = "quarantined"
+	repairedKey    = "repaired"
+)
+
+type scannerMetrics struct {
+	corruptedCount metric.Int64Counter
+	scanTime       metric.Float64Histogram
+}
+
+// WithMetrics enables metrics for the Scanner.
+func (s *Scanner) WithMetrics() error {
+	corruptedCount, err := meter.Int64Counter("eds_store_corrupted_counter",
+		metric.WithDescription("eds store amount of EDSes that failed the integrity scan"))
+	if err != nil {
+		return err
+	}
+
+	scanTime, err := meter.Float64Histogram("eds_store_scan_time_histogram",
+		metric.WithDescription("eds store time taken to scan the integrity of all EDSes"))
+	if err != nil {
+		return err
+	}
+
+	s.metrics = &scannerMetrics{
+		corruptedCount: corruptedCount,
+		scanTime:       scanTime,
+	}
+	return nil
+}
+
+func (m *scannerMetrics) observeCorrupted(ctx context.Context, corrupted *CorruptedEDS) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	m.corruptedCount.Add(ctx, 1, metric.WithAttributes(
+		attribute.String(corruptionKey, string(corrupted.Corruption)),
+		attribute.Bool(quarantinedKey, corrupted.Quarantined),
+		attribute.Bool(repairedKey, corrupted.Repaired)))
+}
+
+func (m *scannerMetrics) observeScan(ctx context.Context, dur time.Duration) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	m.scanTime.Record(ctx, dur.Seconds())
+}
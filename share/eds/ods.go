@@ -3,6 +3,7 @@ package eds
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -11,8 +12,18 @@ import (
 	cbor "github.com/ipfs/go-ipld-cbor"
 	"github.com/ipld/go-car"
 	"github.com/ipld/go-car/util"
+
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/ipld"
 )
 
+// ErrRowRootMismatch is returned by ODSRowReader when a row of the ODS doesn't match its root.
+var ErrRowRootMismatch = errors.New("eds: row doesn't match its root")
+
 // bufferedODSReader will read odsSquareSize amount of leaves from reader into the buffer.
 // It exposes the buffer to be read by io.Reader interface implementation
 type bufferedODSReader struct {
@@ -96,3 +107,188 @@ func (r *bufferedODSReader) readLeaf() error {
 	_, err = r.buf.ReadFrom(io.LimitReader(r.carReader, int64(l)))
 	return err
 }
+
+// WriteODS writes the CARv1 header and the first quadrant (ODS) of the EDS into the given io.Writer.
+// The written bytes are the same as the ones read by ODSReader from the CAR file of the EDS.
+// The header and each row of the ODS are written with a single Write call, so that the ODS can be
+// streamed and verified row by row.
+func WriteODS(eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
+	buf := new(bytes.Buffer)
+	if err := writeHeader(eds, buf); err != nil {
+		return fmt.Errorf("share: writing carv1 header: %w", err)
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	hasher := nmt.NewNmtHasher(sha256.New(), share.NamespaceSize, ipld.NMTIgnoreMaxNamespace)
+	odsWidth := eds.Width() / 2
+	for row := uint(0); row < odsWidth; row++ {
+		buf.Reset()
+		for col := uint(0); col < odsWidth; col++ {
+			if err := writeLeaf(hasher, prependNamespace(0, eds.GetCell(row, col)), buf); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CopyODS copies the CARv1 header and the ODS from the given CARv1 encoded EDS or ODS into the
+// given io.Writer. Like WriteODS, it writes the header and each row of the ODS with a single Write
+// call.
+func CopyODS(w io.Writer, r io.Reader) error {
+	br := bufio.NewReader(r)
+	buf := new(bytes.Buffer)
+	data, err := util.LdRead(br)
+	if err != nil {
+		return fmt.Errorf("reading header: %w", err)
+	}
+	var header car.CarHeader
+	if err = cbor.DecodeInto(data, &header); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+	if err = util.LdWrite(buf, data); err != nil {
+		return err
+	}
+	if _, err = w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	odsWidth := len(header.Roots) / 4
+	for row := 0; row < odsWidth; row++ {
+		buf.Reset()
+		for col := 0; col < odsWidth; col++ {
+			data, err := util.LdRead(br)
+			if err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return fmt.Errorf("reading leaf %d of row %d: %w", col, row, err)
+			}
+			if err = util.LdWrite(buf, data); err != nil {
+				return err
+			}
+		}
+		if _, err = w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ODSSize returns the size of the CARv1 encoded ODS with the given root, as written by WriteODS.
+func ODSSize(root *share.Root) (int64, error) {
+	rootCids, err := axisRootsToCids(root.RowRoots, root.ColumnRoots)
+	if err != nil {
+		return 0, err
+	}
+
+	header := new(bytes.Buffer)
+	err = car.WriteHeader(&car.CarHeader{Roots: rootCids, Version: 1}, header)
+	if err != nil {
+		return 0, err
+	}
+
+	// all the leaves are of the same size: CID of the leaf hash followed by the namespaced share
+	leafCid, err := ipld.CidFromNamespacedSha256(make([]byte, ipld.NmtHashSize))
+	if err != nil {
+		return 0, err
+	}
+	leafSize := uint64(leafCid.ByteLen() + share.NamespaceSize + share.Size)
+	leafSize += uint64(len(binary.AppendUvarint(nil, leafSize)))
+
+	odsWidth := int64(len(root.RowRoots) / 2)
+	return int64(header.Len()) + odsWidth*odsWidth*int64(leafSize), nil
+}
+
+// ODSRowReader reads the CARv1 encoded ODS, as written by WriteODS or read by ODSReader, row by row
+// and verifies each of the rows against its root.
+type ODSRowReader struct {
+	r    *bufio.Reader
+	root *share.Root
+	// next is the index of the next row to be read
+	next int
+}
+
+// NewODSRowReader reads the CARv1 header from the given reader and ensures it matches the given
+// root.
+func NewODSRowReader(r io.Reader, root *share.Root) (*ODSRowReader, error) {
+	br := bufio.NewReader(r)
+	data, err := util.LdRead(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	var header car.CarHeader
+	if err = cbor.DecodeInto(data, &header); err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+
+	rootCids, err := axisRootsToCids(root.RowRoots, root.ColumnRoots)
+	if err != nil {
+		return nil, err
+	}
+	if len(header.Roots) != len(rootCids) {
+		return nil, fmt.Errorf("header roots amount mismatch: expected %d, got %d",
+			len(rootCids), len(header.Roots))
+	}
+	for i := range rootCids {
+		if !header.Roots[i].Equals(rootCids[i]) {
+			return nil, fmt.Errorf("header root %d mismatch", i)
+		}
+	}
+
+	return &ODSRowReader{r: br, root: root}, nil
+}
+
+// Next reads the shares of the next row of the ODS and returns them along with the index of the
+// row, once they are verified against the row root. It returns io.EOF after the last row.
+func (r *ODSRowReader) Next() (int, []share.Share, error) {
+	odsWidth := len(r.root.RowRoots) / 2
+	if r.next >= odsWidth {
+		return 0, nil, io.EOF
+	}
+
+	shares := make([]share.Share, odsWidth)
+	for i := range shares {
+		_, data, err := util.ReadNode(r.r)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, nil, fmt.Errorf("reading share %d of row %d: %w", i, r.next, err)
+		}
+		if len(data) != share.NamespaceSize+share.Size {
+			return 0, nil, fmt.Errorf("invalid share size: %d", len(data))
+		}
+		// the stored shares are wrapped with the namespace twice
+		shares[i] = share.GetData(data)
+	}
+
+	parity, err := share.DefaultRSMT2DCodec().Encode(shares)
+	if err != nil {
+		return 0, nil, fmt.Errorf("encoding row %d: %w", r.next, err)
+	}
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(odsWidth), uint(r.next))
+	for _, shr := range append(shares, parity...) {
+		if err := tree.Push(shr); err != nil {
+			return 0, nil, fmt.Errorf("building row %d tree: %w", r.next, err)
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return 0, nil, fmt.Errorf("computing row %d root: %w", r.next, err)
+	}
+	if !bytes.Equal(root, r.root.RowRoots[r.next]) {
+		return 0, nil, fmt.Errorf("%w: row %d", ErrRowRootMismatch, r.next)
+	}
+
+	idx := r.next
+	r.next++
+	return idx, shares, nil
+}
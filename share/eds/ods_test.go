@@ -1,6 +1,7 @@
 package eds
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"testing"
@@ -108,3 +109,112 @@ func TestODSReaderReconstruction(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, colRoots, loadedColRoots)
 }
+
+// TestWriteODS ensures that WriteODS produces the same ODS as the one read by ODSReader from the
+// Store and that it can be verified row by row with ODSRowReader.
+func TestWriteODS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	eds, dah := randomEDS(t)
+	err = edsStore.Put(ctx, dah.Hash(), eds)
+	require.NoError(t, err)
+
+	r, err := edsStore.GetCAR(ctx, dah.Hash())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	odsR, err := ODSReader(r)
+	require.NoError(t, err)
+	expected, err := io.ReadAll(odsR)
+	require.NoError(t, err)
+
+	// the header and each row are written separately
+	w := &chunksWriter{}
+	err = WriteODS(eds, w)
+	require.NoError(t, err)
+	require.Len(t, w.chunks, int(eds.Width()/2)+1)
+	assert.Equal(t, expected, bytes.Join(w.chunks, nil))
+
+	size, err := ODSSize(dah)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(expected), size)
+
+	rowR, err := NewODSRowReader(bytes.NewReader(expected), dah)
+	require.NoError(t, err)
+	for i := 0; i < int(eds.Width()/2); i++ {
+		idx, shares, err := rowR.Next()
+		require.NoError(t, err)
+		assert.Equal(t, i, idx)
+		assert.Equal(t, eds.Row(uint(i))[:eds.Width()/2], shares)
+	}
+	_, _, err = rowR.Next()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// corrupted shares should fail the verification of their row
+	corrupted := bytes.Clone(expected)
+	corrupted[len(corrupted)-1]++
+	rowR, err = NewODSRowReader(bytes.NewReader(corrupted), dah)
+	require.NoError(t, err)
+	for i := 0; i < int(eds.Width()/2)-1; i++ {
+		_, _, err = rowR.Next()
+		require.NoError(t, err)
+	}
+	_, _, err = rowR.Next()
+	assert.ErrorIs(t, err, ErrRowRootMismatch)
+
+	// ODS of another square should be rejected by its header
+	_, otherDah := randomEDS(t)
+	_, err = NewODSRowReader(bytes.NewReader(expected), otherDah)
+	assert.Error(t, err)
+}
+
+// TestCopyODS ensures that CopyODS copies the same ODS chunks from the stored CAR file as the ones
+// written by WriteODS.
+func TestCopyODS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	eds, dah := randomEDS(t)
+	err = edsStore.Put(ctx, dah.Hash(), eds)
+	require.NoError(t, err)
+
+	expected := &chunksWriter{}
+	err = WriteODS(eds, expected)
+	require.NoError(t, err)
+
+	r, err := edsStore.GetCAR(ctx, dah.Hash())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	w := &chunksWriter{}
+	err = CopyODS(w, r)
+	require.NoError(t, err)
+	assert.Equal(t, expected.chunks, w.chunks)
+
+	// truncated ODS should not be copied silently
+	full := bytes.Join(expected.chunks, nil)
+	err = CopyODS(&chunksWriter{}, bytes.NewReader(full[:len(full)-1]))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+type chunksWriter struct {
+	chunks [][]byte
+}
+
+func (w *chunksWriter) Write(p []byte) (int, error) {
+	w.chunks = append(w.chunks, bytes.Clone(p))
+	return len(p), nil
+}
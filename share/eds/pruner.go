@@ -0,0 +1,262 @@
+package eds
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+var (
+	prunerPrefix     = datastore.NewKey("eds_pruner")
+	lastPrunedKey    = datastore.NewKey("last_pruned")
+	errPrunerStopped = errors.New("eds: pruner is stopped")
+)
+
+// SafeHeightFn returns the highest height that may be pruned. It allows other components, such as
+// the DASer, to protect heights they haven't processed yet.
+type SafeHeightFn func(context.Context) (uint64, error)
+
+// PrunerOption configures the Pruner.
+type PrunerOption func(*Pruner)
+
+// WithSafeHeight limits pruning to heights that are lower or equal to the height returned by the
+// given function.
+func WithSafeHeight(fn SafeHeightFn) PrunerOption {
+	return func(p *Pruner) {
+		p.safeHeight = fn
+	}
+}
+
+// Pruner periodically removes EDSes that fall out of the retention window configured in the
+// Parameters from the Store. A block is pruned only after it is outside every configured window.
+// The pruner walks the chain in height order and persists the last pruned height, so each height
+// is visited only once.
+type Pruner struct {
+	params     *Parameters
+	store      *Store
+	getter     libhead.Getter[*header.ExtendedHeader]
+	ds         datastore.Datastore
+	safeHeight SafeHeightFn
+
+	lastPruned atomic.Uint64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	metrics *prunerMetrics
+}
+
+// NewPruner creates a new Pruner for the given Store.
+func NewPruner(
+	params *Parameters,
+	store *Store,
+	getter libhead.Getter[*header.ExtendedHeader],
+	ds datastore.Datastore,
+	opts ...PrunerOption,
+) (*Pruner, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &Pruner{
+		params: params,
+		store:  store,
+		getter: getter,
+		ds:     namespace.Wrap(ds, prunerPrefix),
+		done:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+// Start loads the last pruned height and starts the background pruning routine. It is a no-op if
+// pruning is disabled.
+func (p *Pruner) Start(ctx context.Context) error {
+	if !p.params.PruningEnabled() {
+		close(p.done)
+		return nil
+	}
+
+	lastPruned, err := p.loadLastPruned(ctx)
+	if err != nil {
+		return err
+	}
+	p.lastPruned.Store(lastPruned)
+	log.Infow("starting eds pruner", "last_pruned", lastPruned,
+		"retention_heights", p.params.RetentionHeights, "retention_period", p.params.RetentionPeriod)
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	go p.run(runCtx)
+	return nil
+}
+
+// Stop stops the background pruning routine and waits for it to finish.
+func (p *Pruner) Stop(ctx context.Context) error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("eds: pruner force quit: %w", ctx.Err())
+	}
+}
+
+// LastPruned returns the last height that was processed by the pruner.
+func (p *Pruner) LastPruned() uint64 {
+	return p.lastPruned.Load()
+}
+
+func (p *Pruner) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.params.PruningInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.prune(ctx); err != nil && ctx.Err() == nil {
+				log.Errorw("pruning eds store", "last_pruned", p.lastPruned.Load(), "err", err)
+			}
+		}
+	}
+}
+
+// prune removes EDSes of all heights from the last pruned one up to the retention window.
+func (p *Pruner) prune(ctx context.Context) (err error) {
+	from := p.lastPruned.Load()
+	defer func() {
+		lastPruned := p.lastPruned.Load()
+		if lastPruned == from {
+			return
+		}
+		// persist progress even if the round failed, so the pruned heights are not visited again
+		if storeErr := p.storeLastPruned(ctx, lastPruned); storeErr != nil {
+			err = errors.Join(err, storeErr)
+		}
+	}()
+
+	to, err := p.pruneBound(ctx)
+	if err != nil {
+		return err
+	}
+
+	for height := from + 1; height <= to; height++ {
+		if ctx.Err() != nil {
+			return errPrunerStopped
+		}
+
+		eh, err := p.getter.GetByHeight(ctx, height)
+		switch {
+		case errors.Is(err, libhead.ErrNotFound):
+			// the header store may start above the pruned height, e.g. if it was initialized from a
+			// trusted hash, so there is nothing to prune for this height
+			p.lastPruned.Store(height)
+			continue
+		case err != nil:
+			return fmt.Errorf("getting header at height %d: %w", height, err)
+		}
+
+		if p.params.RetentionPeriod > 0 && time.Since(eh.Time()) < p.params.RetentionPeriod {
+			// headers are ordered by time, so all the following ones are within the window as well
+			return nil
+		}
+
+		if err := p.pruneHeader(ctx, eh); err != nil {
+			return err
+		}
+		p.lastPruned.Store(height)
+	}
+	return nil
+}
+
+// pruneBound returns the highest height that is outside the height retention window and may be
+// pruned.
+func (p *Pruner) pruneBound(ctx context.Context) (uint64, error) {
+	head, err := p.getter.Head(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting head: %w", err)
+	}
+
+	to := head.Height()
+	if p.params.RetentionHeights > 0 {
+		if to <= p.params.RetentionHeights {
+			return 0, nil
+		}
+		to -= p.params.RetentionHeights
+	}
+
+	if p.safeHeight != nil {
+		safe, err := p.safeHeight(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("getting safe height: %w", err)
+		}
+		to = min(to, safe)
+	}
+	return to, nil
+}
+
+func (p *Pruner) pruneHeader(ctx context.Context, eh *header.ExtendedHeader) error {
+	root := share.DataHash(eh.DAH.Hash())
+	// the empty EDS is shared by all empty blocks and must never be removed
+	if root.IsEmptyRoot() {
+		return nil
+	}
+
+	err := p.store.Remove(ctx, root)
+	if err != nil {
+		// the EDS could have never been stored, e.g. if sampling of the height failed
+		if has, hasErr := p.store.Has(ctx, root); hasErr == nil && !has {
+			return nil
+		}
+		return fmt.Errorf("removing eds at height %d: %w", eh.Height(), err)
+	}
+
+	log.Debugw("pruned eds", "height", eh.Height(), "root", root.String())
+	p.metrics.observePruned(ctx, eh.Height())
+	return nil
+}
+
+func (p *Pruner) loadLastPruned(ctx context.Context) (uint64, error) {
+	bs, err := p.ds.Get(ctx, lastPrunedKey)
+	switch {
+	case errors.Is(err, datastore.ErrNotFound):
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("eds: loading last pruned height: %w", err)
+	case len(bs) != 8:
+		return 0, fmt.Errorf("eds: invalid last pruned height length: %d", len(bs))
+	}
+	return binary.BigEndian.Uint64(bs), nil
+}
+
+func (p *Pruner) storeLastPruned(ctx context.Context, height uint64) error {
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, height)
+	if err := p.ds.Put(ctx, lastPrunedKey, bs); err != nil {
+		return fmt.Errorf("eds: storing last pruned height: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package eds
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+func TestPruner(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, edsStore.Stop(ctx))
+	})
+
+	const amount, emptyHeight = 7, 4
+	getter := &prunerGetterStub{headers: make(map[uint64]*header.ExtendedHeader)}
+	for height := uint64(1); height <= amount; height++ {
+		if height == emptyHeight {
+			continue
+		}
+		eds, dah := randomEDS(t)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		getter.add(height, time.Now(), dah)
+	}
+
+	// empty EDS must survive pruning even if it is outside the window
+	emptyRoot := share.EmptyRoot()
+	require.NoError(t, edsStore.Put(ctx, emptyRoot.Hash(), share.EmptyExtendedDataSquare()))
+	getter.add(emptyHeight, time.Now(), emptyRoot)
+
+	params := DefaultParameters()
+	params.RetentionHeights = 2
+
+	var safeHeight uint64 = 2
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	pruner, err := NewPruner(params, edsStore, getter, ds,
+		WithSafeHeight(func(context.Context) (uint64, error) {
+			return safeHeight, nil
+		}))
+	require.NoError(t, err)
+	require.NoError(t, pruner.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, pruner.Stop(ctx))
+	})
+
+	// only heights up to the safe height are pruned
+	require.NoError(t, pruner.prune(ctx))
+	require.EqualValues(t, 2, pruner.LastPruned())
+	requirePruned(ctx, t, edsStore, getter, 1, 2)
+	requireStored(ctx, t, edsStore, getter, 3, amount)
+
+	// heights within the retention window are kept
+	safeHeight = amount
+	require.NoError(t, pruner.prune(ctx))
+	require.EqualValues(t, amount-params.RetentionHeights, pruner.LastPruned())
+	requirePruned(ctx, t, edsStore, getter, 3, emptyHeight-1)
+	requirePruned(ctx, t, edsStore, getter, emptyHeight+1, 5)
+	requireStored(ctx, t, edsStore, getter, 6, amount)
+	// the empty EDS was reached by the prune round, but is kept
+	has, err := edsStore.Has(ctx, emptyRoot.Hash())
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the pruner resumes from the persisted height after restart
+	restarted, err := NewPruner(params, edsStore, getter, ds)
+	require.NoError(t, err)
+	require.NoError(t, restarted.Start(ctx))
+	require.EqualValues(t, pruner.LastPruned(), restarted.LastPruned())
+	require.NoError(t, restarted.Stop(ctx))
+}
+
+func TestPruner_RetentionPeriod(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, edsStore.Stop(ctx))
+	})
+
+	getter := &prunerGetterStub{headers: make(map[uint64]*header.ExtendedHeader)}
+	times := []time.Time{
+		time.Now().Add(-time.Hour * 3),
+		time.Now().Add(-time.Hour * 2),
+		time.Now(),
+	}
+	for i, tm := range times {
+		eds, dah := randomEDS(t)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		getter.add(uint64(i+1), tm, dah)
+	}
+
+	params := DefaultParameters()
+	params.RetentionPeriod = time.Hour
+
+	pruner, err := NewPruner(params, edsStore, getter, ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+
+	require.NoError(t, pruner.prune(ctx))
+	require.EqualValues(t, 2, pruner.LastPruned())
+	requirePruned(ctx, t, edsStore, getter, 1, 2)
+	requireStored(ctx, t, edsStore, getter, 3, 3)
+}
+
+func requirePruned(
+	ctx context.Context,
+	t *testing.T,
+	store *Store,
+	getter *prunerGetterStub,
+	from, to uint64,
+) {
+	for height := from; height <= to; height++ {
+		has, err := store.Has(ctx, getter.headers[height].DAH.Hash())
+		require.NoError(t, err)
+		require.False(t, has, "height %d is not pruned", height)
+	}
+}
+
+func requireStored(
+	ctx context.Context,
+	t *testing.T,
+	store *Store,
+	getter *prunerGetterStub,
+	from, to uint64,
+) {
+	for height := from; height <= to; height++ {
+		has, err := store.Has(ctx, getter.headers[height].DAH.Hash())
+		require.NoError(t, err)
+		require.True(t, has, "height %d is pruned", height)
+	}
+}
+
+type prunerGetterStub struct {
+	head    uint64
+	headers map[uint64]*header.ExtendedHeader
+}
+
+func (g *prunerGetterStub) add(height uint64, tm time.Time, dah *share.Root) {
+	g.headers[height] = &header.ExtendedHeader{
+		RawHeader: header.RawHeader{Height: int64(height), Time: tm},
+		DAH:       dah,
+	}
+	g.head = max(g.head, height)
+}
+
+func (g *prunerGetterStub) Head(
+	context.Context,
+	...libhead.HeadOption[*header.ExtendedHeader],
+) (*header.ExtendedHeader, error) {
+	return g.headers[g.head], nil
+}
+
+func (g *prunerGetterStub) GetByHeight(_ context.Context, height uint64) (*header.ExtendedHeader, error) {
+	eh, ok := g.headers[height]
+	if !ok {
+		return nil, libhead.ErrNotFound
+	}
+	return eh, nil
+}
+
+func (g *prunerGetterStub) GetRangeByHeight(
+	context.Context,
+	*header.ExtendedHeader,
+	uint64,
+) ([]*header.ExtendedHeader, error) {
+	return nil, nil
+}
+
+func (g *prunerGetterStub) Get(context.Context, libhead.Hash) (*header.ExtendedHeader, error) {
+	return nil, libhead.ErrNotFound
+}
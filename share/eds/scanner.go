@@ -0,0 +1,388 @@
+package eds
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/ipld/go-car"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+const (
+	quarantinePath = "/quarantine/"
+
+	// refetchTimeout limits the time the scanner waits for peers to serve a corrupted EDS.
+	refetchTimeout = time.Minute * 5
+)
+
+// Corruption describes which part of a stored EDS failed the integrity check.
+type Corruption string
+
+const (
+	// CorruptHeader means the stored roots don't match the DataHash of the EDS.
+	CorruptHeader Corruption = "header"
+	// CorruptData means the stored shares don't match the roots of the EDS.
+	CorruptData Corruption = "data"
+	// CorruptIndex means some shares of the EDS are missing in the inverted index.
+	CorruptIndex Corruption = "index"
+)
+
+// CorruptedEDS describes an EDS that failed the integrity check and what was done about it.
+type CorruptedEDS struct {
+	DataHash   share.DataHash `json:"data_hash"`
+	Corruption Corruption     `json:"corruption"`
+	Error      string         `json:"error"`
+	// Quarantined is set if the files of the EDS were moved aside and the EDS was removed from the
+	// Store.
+	Quarantined bool `json:"quarantined"`
+	// Repaired is set if the EDS was stored again, either reindexed or re-fetched from peers.
+	Repaired bool `json:"repaired"`
+}
+
+// Health is the result of the integrity scans of the Store.
+type Health struct {
+	// Scanning is set while a scan is in progress.
+	Scanning bool `json:"scanning"`
+	// LastScan is the time the last complete scan finished at.
+	LastScan time.Time `json:"last_scan"`
+	// Scanned is the amount of EDSes checked by the last complete scan.
+	Scanned int `json:"scanned"`
+	// Corrupted lists the EDSes that failed the last complete scan.
+	Corrupted []CorruptedEDS `json:"corrupted"`
+}
+
+// Scanner verifies the integrity of all the EDSes in the Store on start and then periodically, as
+// configured by the Parameters. Squares with corrupted data are quarantined and re-fetched through
+// the given Getter, while squares with missing inverted index entries are reindexed. Squares with
+// a corrupted header can only be quarantined, as their roots are unknown.
+type Scanner struct {
+	params *Parameters
+	store  *Store
+	getter share.Getter
+
+	scanning atomic.Bool
+	health   atomic.Pointer[Health]
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	metrics *scannerMetrics
+}
+
+// NewScanner creates a new Scanner for the given Store.
+func NewScanner(params *Parameters, store *Store, getter share.Getter) (*Scanner, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	s := &Scanner{
+		params: params,
+		store:  store,
+		getter: getter,
+		done:   make(chan struct{}),
+	}
+	s.health.Store(&Health{})
+	return s, nil
+}
+
+// Start starts the background scanning routine. It is a no-op if scanning is disabled.
+func (s *Scanner) Start(context.Context) error {
+	if s.params.IntegrityScanInterval == 0 {
+		close(s.done)
+		return nil
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.run(runCtx)
+	return nil
+}
+
+// Stop stops the background scanning routine and waits for it to finish.
+func (s *Scanner) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("eds: scanner force quit: %w", ctx.Err())
+	}
+}
+
+// Health returns the results of the last complete scan.
+func (s *Scanner) Health() *Health {
+	health := *s.health.Load()
+	health.Scanning = s.scanning.Load()
+	return &health
+}
+
+func (s *Scanner) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.params.IntegrityScanInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.scan(ctx); err != nil && ctx.Err() == nil {
+			log.Errorw("scanning eds store", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scan checks all the EDSes in the Store and handles the corrupted ones.
+func (s *Scanner) scan(ctx context.Context) error {
+	s.scanning.Store(true)
+	defer s.scanning.Store(false)
+
+	start := time.Now()
+	roots, err := s.store.List()
+	if err != nil {
+		return fmt.Errorf("listing EDSes: %w", err)
+	}
+
+	log.Infow("scanning eds store", "amount", len(roots))
+	health := &Health{Corrupted: []CorruptedEDS{}}
+	for _, root := range roots {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		corrupted, err := s.check(ctx, root)
+		if err != nil {
+			return fmt.Errorf("checking EDS %s: %w", root.String(), err)
+		}
+		health.Scanned++
+		if corrupted == nil {
+			continue
+		}
+
+		log.Warnw("found corrupted eds", "root", root.String(),
+			"corruption", corrupted.Corruption, "err", corrupted.Error)
+		s.handle(ctx, corrupted)
+		health.Corrupted = append(health.Corrupted, *corrupted)
+		s.metrics.observeCorrupted(ctx, corrupted)
+	}
+
+	health.LastScan = time.Now()
+	s.health.Store(health)
+	s.metrics.observeScan(ctx, time.Since(start))
+	log.Infow("finished scanning eds store", "scanned", health.Scanned,
+		"corrupted", len(health.Corrupted), "took", time.Since(start))
+	return nil
+}
+
+// check verifies the header, the shares and the inverted index entries of the EDS. It returns nil
+// if the EDS is intact or was removed concurrently.
+func (s *Scanner) check(ctx context.Context, root share.DataHash) (*CorruptedEDS, error) {
+	corrupted := func(corruption Corruption, err error) (*CorruptedEDS, error) {
+		if s.removed(ctx, root) {
+			return nil, nil
+		}
+		return &CorruptedEDS{DataHash: root, Corruption: corruption, Error: err.Error()}, nil
+	}
+
+	if _, err := s.store.GetDAH(ctx, root); err != nil {
+		return corrupted(CorruptHeader, err)
+	}
+	if _, err := s.store.Get(ctx, root); err != nil {
+		return corrupted(CorruptData, err)
+	}
+
+	err := s.checkIndex(ctx, root)
+	if errors.Is(err, ErrNotFoundInIndex) {
+		return corrupted(CorruptIndex, err)
+	}
+	if err != nil && !s.removed(ctx, root) {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// checkIndex ensures that every share of the ODS is present in the inverted index. The entry may
+// point to another EDS containing the same share, as any of them can serve it.
+func (s *Scanner) checkIndex(ctx context.Context, root share.DataHash) error {
+	r, err := s.store.GetCAR(ctx, root)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog("car reader", r)
+
+	odsR, err := ODSReader(r)
+	if err != nil {
+		return err
+	}
+	carReader, err := car.NewCarReader(odsR)
+	if err != nil {
+		return err
+	}
+
+	for {
+		block, err := carReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = s.store.invertedIdx.GetShardsForMultihash(ctx, block.Cid().Hash())
+		if err != nil {
+			return fmt.Errorf("share %s: %w", block.Cid(), err)
+		}
+	}
+}
+
+// handle quarantines or reindexes the corrupted EDS and re-fetches it if possible.
+func (s *Scanner) handle(ctx context.Context, corrupted *CorruptedEDS) {
+	root := corrupted.DataHash
+	if corrupted.Corruption == CorruptIndex {
+		err := s.reindex(ctx, root)
+		if err != nil {
+			log.Errorw("reindexing eds", "root", root.String(), "err", err)
+			return
+		}
+		corrupted.Repaired = true
+		return
+	}
+
+	err := s.quarantine(ctx, root)
+	if err != nil {
+		log.Errorw("quarantining eds", "root", root.String(), "err", err)
+		return
+	}
+	corrupted.Quarantined = true
+
+	if corrupted.Corruption != CorruptData {
+		return
+	}
+	err = s.refetch(ctx, root)
+	if err != nil {
+		log.Errorw("re-fetching eds", "root", root.String(), "err", err)
+		return
+	}
+	corrupted.Repaired = true
+}
+
+// quarantine keeps the files of the EDS in the quarantine directory for inspection and removes the
+// EDS from the Store.
+func (s *Scanner) quarantine(ctx context.Context, root share.DataHash) error {
+	dir := s.store.basepath + quarantinePath
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("creating quarantine directory: %w", err)
+	}
+
+	path := dir + root.String()
+	// replace the files quarantined by previous scans with the latest ones
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	// the file could be moved to the cold storage tier, which may be on another mount
+	file, err := filepath.EvalSymlinks(s.store.storage.filePath(root))
+	if err != nil {
+		return fmt.Errorf("resolving EDS file: %w", err)
+	}
+	if err := os.Link(file, path); err != nil {
+		if err := copyFile(file, path); err != nil {
+			return fmt.Errorf("copying EDS file: %w", err)
+		}
+	}
+	return s.store.Remove(ctx, root)
+}
+
+// refetch downloads the EDS with verified roots from peers and stores it.
+func (s *Scanner) refetch(ctx context.Context, root share.DataHash) error {
+	var square *rsmt2d.ExtendedDataSquare
+	if root.IsEmptyRoot() {
+		square = share.EmptyExtendedDataSquare()
+	} else {
+		dah, err := s.quarantinedDAH(root)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, refetchTimeout)
+		defer cancel()
+		// the getter only needs the roots of the header to request and verify the EDS
+		square, err = s.getter.GetEDS(ctx, &header.ExtendedHeader{DAH: dah})
+		if err != nil {
+			return err
+		}
+	}
+	return s.store.Put(ctx, root, square)
+}
+
+// quarantinedDAH reads the roots of the quarantined EDS, which were verified by the scan before the
+// shares failed the check.
+func (s *Scanner) quarantinedDAH(root share.DataHash) (*share.Root, error) {
+	f, err := os.Open(s.store.basepath + quarantinePath + root.String())
+	if err != nil {
+		return nil, err
+	}
+	defer closeAndLog("quarantined file", f)
+
+	var dah *share.Root
+	switch s.params.Storage {
+	case ODSStorage:
+		hdr, err := readODSFileHeader(f)
+		if err != nil {
+			return nil, err
+		}
+		dah = hdr.root
+	default:
+		carHeader, err := car.ReadHeader(bufio.NewReader(f))
+		if err != nil {
+			return nil, err
+		}
+		dah = dahFromCARHeader(carHeader)
+	}
+
+	if !bytes.Equal(dah.Hash(), root) {
+		return nil, fmt.Errorf("quarantined roots mismatch the data hash %s", root.String())
+	}
+	return dah, nil
+}
+
+// reindex adds the missing inverted index entries of the verified EDS in place, keeping the EDS in
+// the Store.
+func (s *Scanner) reindex(ctx context.Context, root share.DataHash) error {
+	lk := &s.store.stripedLocks[root[len(root)-1]]
+	lk.Lock()
+	defer lk.Unlock()
+
+	// the EDS could be removed since it was checked
+	has, err := s.store.Has(ctx, root)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return ErrNotFound
+	}
+	return s.store.storage.addToIndex(ctx, root)
+}
+
+// removed reports whether the EDS was removed from the Store, e.g. by the Pruner, while it was
+// being checked.
+func (s *Scanner) removed(ctx context.Context, root share.DataHash) bool {
+	has, err := s.store.Has(ctx, root)
+	return err == nil && !has
+}
@@ -0,0 +1,160 @@
+package eds
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/dagstore/shard"
+	ds "github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+func TestScanner(t *testing.T) {
+	for _, storage := range []StorageType{CARStorage, ODSStorage} {
+		t.Run(string(storage), func(t *testing.T) {
+			testScanner(t, storage)
+		})
+	}
+}
+
+func testScanner(t *testing.T, storage StorageType) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	params := DefaultParameters()
+	params.Storage = storage
+	edsStore, err := NewStore(params, t.TempDir(), ds_sync.MutexWrap(ds.NewMapDatastore()))
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, edsStore.Stop(ctx))
+	})
+
+	getter := &scannerGetterStub{squares: make(map[string]*rsmt2d.ExtendedDataSquare)}
+	put := func() (*rsmt2d.ExtendedDataSquare, share.DataHash) {
+		eds, dah := randomEDS(t)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		// make sure the following reads hit the corrupted files rather than cached accessors
+		require.NoError(t, edsStore.cache.Load().Remove(shard.KeyFromString(dah.String())))
+		getter.squares[dah.String()] = eds
+		return eds, dah.Hash()
+	}
+
+	_, intactRoot := put()
+
+	// flip a byte in the middle of the first share
+	dataSquare, dataRoot := put()
+	path := edsStore.storage.filePath(dataRoot)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	offset := bytes.Index(content, dataSquare.GetCell(0, 0))
+	require.Positive(t, offset)
+	content[offset+share.Size/2] ^= 0xFF
+	require.NoError(t, os.WriteFile(path, content, 0600))
+
+	// break the file header holding the roots
+	_, headerRoot := put()
+	path = edsStore.storage.filePath(headerRoot)
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	copy(content[1:], bytes.Repeat([]byte{0xFF}, 32))
+	require.NoError(t, os.WriteFile(path, content, 0600))
+
+	// drop the inverted index entry of the first share
+	_, indexRoot := put()
+	r, err := edsStore.GetCAR(ctx, indexRoot)
+	require.NoError(t, err)
+	carReader, err := car.NewCarReader(r)
+	require.NoError(t, err)
+	leaf, err := carReader.Next()
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	err = edsStore.invertedIdx.ds.Delete(ctx, mhKey(leaf.Cid().Hash()))
+	require.NoError(t, err)
+	indexFile, err := os.Stat(edsStore.storage.filePath(indexRoot))
+	require.NoError(t, err)
+
+	scanner, err := NewScanner(params, edsStore, getter)
+	require.NoError(t, err)
+	require.NoError(t, scanner.scan(ctx))
+
+	health := scanner.Health()
+	assert.False(t, health.Scanning)
+	assert.False(t, health.LastScan.IsZero())
+	assert.Equal(t, 4, health.Scanned)
+	require.Len(t, health.Corrupted, 3)
+
+	corrupted := make(map[string]CorruptedEDS)
+	for _, c := range health.Corrupted {
+		corrupted[c.DataHash.String()] = c
+	}
+	assert.NotContains(t, corrupted, intactRoot.String())
+
+	// corrupted data is quarantined and re-fetched
+	c := corrupted[dataRoot.String()]
+	assert.Equal(t, CorruptData, c.Corruption)
+	assert.True(t, c.Quarantined)
+	assert.True(t, c.Repaired)
+	got, err := edsStore.Get(ctx, dataRoot)
+	require.NoError(t, err)
+	assert.True(t, dataSquare.Equals(got))
+	_, err = os.Stat(edsStore.basepath + quarantinePath + dataRoot.String())
+	assert.NoError(t, err)
+
+	// corrupted header is quarantined only, as the roots are unknown
+	c = corrupted[headerRoot.String()]
+	assert.Equal(t, CorruptHeader, c.Corruption)
+	assert.True(t, c.Quarantined)
+	assert.False(t, c.Repaired)
+	has, err := edsStore.Has(ctx, headerRoot)
+	require.NoError(t, err)
+	assert.False(t, has)
+	_, err = os.Stat(edsStore.basepath + quarantinePath + headerRoot.String())
+	assert.NoError(t, err)
+
+	// missing index entries are restored
+	c = corrupted[indexRoot.String()]
+	assert.Equal(t, CorruptIndex, c.Corruption)
+	assert.False(t, c.Quarantined)
+	assert.True(t, c.Repaired)
+	has, err = edsStore.Blockstore().Has(ctx, leaf.Cid())
+	require.NoError(t, err)
+	assert.True(t, has)
+	// in place, without storing the EDS again
+	reindexedFile, err := os.Stat(edsStore.storage.filePath(indexRoot))
+	require.NoError(t, err)
+	assert.True(t, os.SameFile(indexFile, reindexedFile))
+
+	// the next scan finds the store intact
+	require.NoError(t, scanner.scan(ctx))
+	health = scanner.Health()
+	assert.Equal(t, 3, health.Scanned)
+	assert.Empty(t, health.Corrupted)
+}
+
+type scannerGetterStub struct {
+	share.Getter
+	squares map[string]*rsmt2d.ExtendedDataSquare
+}
+
+func (s *scannerGetterStub) GetEDS(
+	_ context.Context,
+	eh *header.ExtendedHeader,
+) (*rsmt2d.ExtendedDataSquare, error) {
+	eds, ok := s.squares[eh.DAH.String()]
+	if !ok {
+		return nil, share.ErrNotFound
+	}
+	return eds, nil
+}
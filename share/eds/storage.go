@@ -0,0 +1,100 @@
+package eds
+
+import (
+	"context"
+	"io"
+
+	"github.com/filecoin-project/dagstore/shard"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/cache"
+)
+
+// Storage persists the EDSes of the Store. The Store traces and measures the operations, while the
+// Storage defines how the EDSes are laid out on disk.
+type Storage interface {
+	// Put stores the given EDS with the given DataHash as a key. It returns
+	// dagstore.ErrShardExists if the EDS is already stored.
+	Put(context.Context, share.DataHash, *rsmt2d.ExtendedDataSquare) error
+	// Get reads the EDS by the given DataHash, verifying its integrity.
+	Get(context.Context, share.DataHash) (*rsmt2d.ExtendedDataSquare, error)
+	// GetDAH reads the roots of the EDS by the given DataHash.
+	GetDAH(context.Context, share.DataHash) (*share.Root, error)
+	// GetCAR returns a reader of the EDS by the given DataHash serialized as a CARv1 file, which
+	// contains at least the header and the first quadrant.
+	GetCAR(context.Context, share.DataHash) (io.ReadCloser, error)
+	// CARBlockstore returns a blockstore over the shares and NMT nodes of the EDS by the given
+	// DataHash.
+	CARBlockstore(context.Context, share.DataHash) (*BlockstoreCloser, error)
+	// Has reports whether the EDS by the given DataHash is stored.
+	Has(context.Context, share.DataHash) (bool, error)
+	// Remove removes the EDS by the given DataHash along with its indexes.
+	Remove(context.Context, share.DataHash) error
+	// List lists the DataHashes of all the stored EDSes.
+	List() ([]share.DataHash, error)
+}
+
+// accessorStorage is a Storage able to load accessors to its EDSes for the caches of the Store.
+type accessorStorage interface {
+	Storage
+
+	getAccessor(context.Context, shard.Key) (cache.Accessor, error)
+	// filePath returns the path of the file the EDS by the given DataHash is stored in.
+	filePath(share.DataHash) string
+	// addToIndex adds the multihashes of the stored EDS by the given DataHash to the inverted index.
+	addToIndex(context.Context, share.DataHash) error
+}
+
+var _ accessorStorage = (*carStorage)(nil)
+
+// carStorage is the Storage keeping whole EDSes as CARv1 files registered on the DAGStore of the
+// Store.
+type carStorage struct {
+	store *Store
+}
+
+func (cs *carStorage) Put(ctx context.Context, root share.DataHash, square *rsmt2d.ExtendedDataSquare) error {
+	return cs.store.put(ctx, root, square)
+}
+
+func (cs *carStorage) Get(ctx context.Context, root share.DataHash) (*rsmt2d.ExtendedDataSquare, error) {
+	return cs.store.get(ctx, root)
+}
+
+func (cs *carStorage) GetDAH(ctx context.Context, root share.DataHash) (*share.Root, error) {
+	return cs.store.getDAH(ctx, root)
+}
+
+func (cs *carStorage) GetCAR(ctx context.Context, root share.DataHash) (io.ReadCloser, error) {
+	return cs.store.getCAR(ctx, root)
+}
+
+func (cs *carStorage) CARBlockstore(ctx context.Context, root share.DataHash) (*BlockstoreCloser, error) {
+	return cs.store.carBlockstore(ctx, root)
+}
+
+func (cs *carStorage) Has(ctx context.Context, root share.DataHash) (bool, error) {
+	return cs.store.has(ctx, root)
+}
+
+func (cs *carStorage) Remove(ctx context.Context, root share.DataHash) error {
+	return cs.store.remove(ctx, root)
+}
+
+func (cs *carStorage) List() ([]share.DataHash, error) {
+	return cs.store.list()
+}
+
+func (cs *carStorage) getAccessor(ctx context.Context, key shard.Key) (cache.Accessor, error) {
+	return cs.store.getAccessor(ctx, key)
+}
+
+func (cs *carStorage) filePath(root share.DataHash) string {
+	return cs.store.basepath + blocksPath + root.String()
+}
+
+func (cs *carStorage) addToIndex(ctx context.Context, root share.DataHash) error {
+	return cs.store.addInvertedIndex(ctx, shard.KeyFromString(root.String()))
+}
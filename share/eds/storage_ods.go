@@ -0,0 +1,509 @@
+package eds
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/filecoin-project/dagstore"
+	"github.com/filecoin-project/dagstore/shard"
+	"github.com/ipld/go-car"
+	carv2 "github.com/ipld/go-car/v2"
+	carbs "github.com/ipld/go-car/v2/blockstore"
+	"github.com/multiformats/go-multihash"
+
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/cache"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+const (
+	odsPath = "/ods/"
+	// tmpExt is the extension of ODS files being written.
+	tmpExt = ".tmp"
+)
+
+var _ accessorStorage = (*odsStorage)(nil)
+
+// odsStorage is the Storage keeping only the ODS of each EDS in a compact file named after its
+// DataHash. The file consists of the width of the ODS, the roots of the EDS, the offsets of the
+// ODS rows in the file and the rows themselves, all of the fixed size. The parity data and the NMT
+// Merkle Proofs are recomputed on read. The shares and the proofs of every EDS are indexed on the
+// inverted index of the Store, s.t. store.Blockstore can access them.
+type odsStorage struct {
+	store *Store
+	path  string
+}
+
+func newODSStorage(store *Store, basePath string) (*odsStorage, error) {
+	path := basePath + odsPath
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ods directory: %w", err)
+	}
+	return &odsStorage{
+		store: store,
+		path:  path,
+	}, nil
+}
+
+func (s *odsStorage) Put(ctx context.Context, root share.DataHash, square *rsmt2d.ExtendedDataSquare) error {
+	lk := &s.store.stripedLocks[root[len(root)-1]]
+	lk.Lock()
+	defer lk.Unlock()
+
+	// if root already exists, short-circuit
+	if has, _ := s.Has(ctx, root); has {
+		return dagstore.ErrShardExists
+	}
+
+	dah, err := share.NewRoot(square)
+	if err != nil {
+		return err
+	}
+
+	// the file is written under a temporary name first, so that it is never read partially written
+	path := s.filePath(root)
+	err = writeODSFile(path+tmpExt, dah, square)
+	if err != nil {
+		return fmt.Errorf("failed to write ODS file: %w", err)
+	}
+
+	key := shard.KeyFromString(root.String())
+	ac, err := newODSAccessor(ctx, square.FlattenedODS())
+	if err == nil {
+		err = s.store.invertedIdx.AddMultihashesForShard(ctx, ac, key)
+	}
+	if err == nil {
+		err = os.Rename(path+tmpExt, path)
+	}
+	if err != nil {
+		if rmErr := os.Remove(path + tmpExt); rmErr != nil {
+			log.Warnw("removing ODS file", "err", rmErr)
+		}
+		return fmt.Errorf("failed to index ODS file: %w", err)
+	}
+
+	// the accessor is computed already, so put it in the recent blocks cache right away
+	cached, err := s.store.cache.Load().First().GetOrLoad(ctx, key,
+		func(context.Context, shard.Key) (cache.Accessor, error) {
+			return ac, nil
+		})
+	if err != nil {
+		log.Warnw("unable to put accessor to recent blocks accessors cache", "err", err)
+		return nil
+	}
+	// need to close returned accessor to remove the reader reference
+	closeAndLog("accessor", cached)
+	return nil
+}
+
+func (s *odsStorage) Get(ctx context.Context, root share.DataHash) (*rsmt2d.ExtendedDataSquare, error) {
+	hdr, shares, err := s.read(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// use proofs adder if provided, to cache collected proofs while recomputing the eds
+	var opts []nmt.Option
+	visitor := ipld.ProofsAdderFromCtx(ctx).VisitFn()
+	if visitor != nil {
+		opts = append(opts, nmt.NodeVisitor(visitor))
+	}
+
+	square, err := rsmt2d.ComputeExtendedDataSquare(
+		shares,
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(uint64(hdr.odsWidth()), opts...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute eds: %w", err)
+	}
+
+	dah, err := share.NewRoot(square)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(dah.Hash(), root) {
+		return nil, fmt.Errorf("content integrity mismatch: computed root %s doesn't match expected root %s",
+			share.DataHash(dah.Hash()), root)
+	}
+	return square, nil
+}
+
+func (s *odsStorage) GetDAH(_ context.Context, root share.DataHash) (*share.Root, error) {
+	f, err := s.open(root)
+	if err != nil {
+		return nil, err
+	}
+	defer closeAndLog("ods file", f)
+
+	hdr, err := readODSFileHeader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ODS file header: %w", err)
+	}
+	if !bytes.Equal(hdr.root.Hash(), root) {
+		return nil, fmt.Errorf("content integrity mismatch from ODS file for root %x", root)
+	}
+	return hdr.root, nil
+}
+
+// GetCAR serves the cached CAR file of the EDS if there is one, otherwise it serializes only the
+// header and the ODS read from the file, without recomputing the parity.
+func (s *odsStorage) GetCAR(_ context.Context, root share.DataHash) (io.ReadCloser, error) {
+	key := shard.KeyFromString(root.String())
+	accessor, err := s.store.cache.Load().Get(key)
+	if err == nil {
+		return newReadCloser(accessor), nil
+	}
+
+	hdr, shares, err := s.read(root)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	err = writeODSCAR(hdr.root, shares, buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write CAR file: %w", err)
+	}
+	return io.NopCloser(buf), nil
+}
+
+func (s *odsStorage) CARBlockstore(ctx context.Context, root share.DataHash) (*BlockstoreCloser, error) {
+	key := shard.KeyFromString(root.String())
+	accessor, err := s.store.cache.Load().Get(key)
+	if err == nil {
+		return blockstoreCloser(accessor)
+	}
+
+	ac, err := s.getAccessor(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accessor: %w", err)
+	}
+	return blockstoreCloser(ac)
+}
+
+func (s *odsStorage) Has(_ context.Context, root share.DataHash) (bool, error) {
+	_, err := os.Stat(s.filePath(root))
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, os.ErrNotExist):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (s *odsStorage) Remove(ctx context.Context, root share.DataHash) error {
+	lk := &s.store.stripedLocks[root[len(root)-1]]
+	lk.Lock()
+	defer lk.Unlock()
+
+	key := shard.KeyFromString(root.String())
+	// remove open links to accessor from cache
+	if err := s.store.cache.Load().Remove(key); err != nil {
+		log.Warnw("remove accessor from cache", "err", err)
+	}
+
+	// the index entries are only known from the recomputed EDS, so they are dropped first
+	ac, err := s.accessor(ctx, root)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return err
+	case err != nil:
+		log.Warnw("failed to recompute EDS to drop inverted index entries", "key", key, "err", err)
+	default:
+		if err := s.store.invertedIdx.dropMultihashesForShard(ctx, ac, key); err != nil {
+			log.Warnw("failed to drop inverted index entries", "key", key, "err", err)
+		}
+	}
+
+	err = removeFile(s.filePath(root))
+	if err != nil {
+		return fmt.Errorf("failed to remove ODS file: %w", err)
+	}
+	return nil
+}
+
+func (s *odsStorage) List() ([]share.DataHash, error) {
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]share.DataHash, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), tmpExt) {
+			continue
+		}
+		hash, err := hex.DecodeString(entry.Name())
+		if err != nil || share.DataHash(hash).Validate() != nil {
+			log.Warnw("unexpected file in ods directory", "name", entry.Name())
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+func (s *odsStorage) getAccessor(ctx context.Context, key shard.Key) (cache.Accessor, error) {
+	return s.accessor(ctx, share.MustDataHashFromString(key.String()))
+}
+
+// accessor recomputes the EDS from the ODS file along with all of its NMT Merkle Proofs.
+func (s *odsStorage) accessor(ctx context.Context, root share.DataHash) (*odsAccessor, error) {
+	hdr, shares, err := s.read(root)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hdr.root.Hash(), root) {
+		return nil, fmt.Errorf("content integrity mismatch from ODS file for root %x", root)
+	}
+	return newODSAccessor(ctx, shares)
+}
+
+func (s *odsStorage) addToIndex(ctx context.Context, root share.DataHash) error {
+	ac, err := s.accessor(ctx, root)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog("accessor", ac)
+	return s.store.invertedIdx.AddMultihashesForShard(ctx, ac, shard.KeyFromString(root.String()))
+}
+
+func (s *odsStorage) filePath(root share.DataHash) string {
+	return s.path + root.String()
+}
+
+func (s *odsStorage) open(root share.DataHash) (*os.File, error) {
+	f, err := os.Open(s.filePath(root))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+// read reads the header and the shares of the ODS file.
+func (s *odsStorage) read(root share.DataHash) (*odsFileHeader, []share.Share, error) {
+	f, err := s.open(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeAndLog("ods file", f)
+
+	hdr, err := readODSFileHeader(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read ODS file header: %w", err)
+	}
+	shares, err := hdr.readShares(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read ODS file: %w", err)
+	}
+	return hdr, shares, nil
+}
+
+// odsAccessor is the cache.Accessor over the CARv1 file of the EDS recomputed from its ODS, which
+// includes all the NMT Merkle Proofs.
+type odsAccessor struct {
+	car []byte
+}
+
+func newODSAccessor(ctx context.Context, shares []share.Share) (*odsAccessor, error) {
+	odsWidth := uint64(math.Sqrt(float64(len(shares))))
+	adder := ipld.NewProofsAdder(int(odsWidth * 2))
+	defer adder.Purge()
+
+	square, err := rsmt2d.ComputeExtendedDataSquare(
+		shares,
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(odsWidth, nmt.NodeVisitor(adder.VisitFn())),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute eds: %w", err)
+	}
+	// computing the roots builds all the trees, which collects the proofs
+	if _, err = share.NewRoot(square); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	err = WriteEDS(ipld.CtxWithProofsAdder(ctx, adder), square, buf)
+	if err != nil {
+		return nil, err
+	}
+	return &odsAccessor{car: buf.Bytes()}, nil
+}
+
+func (a *odsAccessor) Blockstore() (dagstore.ReadBlockstore, error) {
+	return carbs.NewReadOnly(bytes.NewReader(a.car), nil, carv2.ZeroLengthSectionAsEOF(true))
+}
+
+func (a *odsAccessor) Reader() io.Reader {
+	return bytes.NewReader(a.car)
+}
+
+func (a *odsAccessor) Size() int64 {
+	return int64(len(a.car))
+}
+
+func (a *odsAccessor) Close() error {
+	return nil
+}
+
+// ForEach iterates over the multihashes of all the blocks in the CAR file, s.t. they can be indexed.
+func (a *odsAccessor) ForEach(fn func(mh multihash.Multihash) error) error {
+	r, err := car.NewCarReader(bytes.NewReader(a.car))
+	if err != nil {
+		return err
+	}
+	for {
+		block, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(block.Cid().Hash()); err != nil {
+			return err
+		}
+	}
+}
+
+// odsFileHeader is the header of the ODS file preceding the rows of the ODS.
+type odsFileHeader struct {
+	root *share.Root
+	// offsets are the offsets of the ODS rows from the start of the file
+	offsets []uint64
+}
+
+func (h *odsFileHeader) odsWidth() int {
+	return len(h.root.RowRoots) / 2
+}
+
+// readShares reads the rows of the ODS at the offsets from the header.
+func (h *odsFileHeader) readShares(r io.ReaderAt) ([]share.Share, error) {
+	odsWidth := h.odsWidth()
+	shares := make([]share.Share, 0, odsWidth*odsWidth)
+	for _, offset := range h.offsets {
+		row := make([]byte, odsWidth*share.Size)
+		if _, err := r.ReadAt(row, int64(offset)); err != nil {
+			return nil, err
+		}
+		for i := 0; i < odsWidth; i++ {
+			shares = append(shares, row[i*share.Size:(i+1)*share.Size])
+		}
+	}
+	return shares, nil
+}
+
+// odsFileHeaderSize returns the size of the ODS file header for the ODS of the given width.
+func odsFileHeaderSize(odsWidth int) int {
+	// the width, the row and column roots and the offsets of the rows
+	return 4 + 4*odsWidth*ipld.NmtHashSize + 8*odsWidth
+}
+
+func writeODSFile(path string, root *share.Root, square *rsmt2d.ExtendedDataSquare) (err error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+
+	odsWidth := int(square.Width() / 2)
+	buf := bytes.NewBuffer(make([]byte, 0, odsFileHeaderSize(odsWidth)+odsWidth*odsWidth*share.Size))
+	buf.Write(binary.BigEndian.AppendUint32(nil, uint32(odsWidth)))
+	for _, roots := range [][][]byte{root.RowRoots, root.ColumnRoots} {
+		for _, r := range roots {
+			buf.Write(r)
+		}
+	}
+
+	rowSize := odsWidth * share.Size
+	for i := 0; i < odsWidth; i++ {
+		offset := odsFileHeaderSize(odsWidth) + i*rowSize
+		buf.Write(binary.BigEndian.AppendUint64(nil, uint64(offset)))
+	}
+
+	for _, shr := range square.FlattenedODS() {
+		buf.Write(shr)
+	}
+
+	// write whole buffered file in one go to optimize i/o
+	_, err = buf.WriteTo(f)
+	return err
+}
+
+func readODSFileHeader(r io.Reader) (*odsFileHeader, error) {
+	var odsWidth uint32
+	err := binary.Read(r, binary.BigEndian, &odsWidth)
+	if err != nil {
+		return nil, err
+	}
+	if odsWidth == 0 || int(odsWidth) > share.MaxSquareSize {
+		return nil, fmt.Errorf("invalid ODS width %d", odsWidth)
+	}
+
+	roots := make([][]byte, 4*odsWidth)
+	for i := range roots {
+		roots[i] = make([]byte, ipld.NmtHashSize)
+		if _, err := io.ReadFull(r, roots[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	offsets := make([]uint64, odsWidth)
+	err = binary.Read(r, binary.BigEndian, offsets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &odsFileHeader{
+		root: &share.Root{
+			RowRoots:    roots[:2*odsWidth],
+			ColumnRoots: roots[2*odsWidth:],
+		},
+		offsets: offsets,
+	}, nil
+}
+
+// writeODSCAR writes the CARv1 header with the given roots and the given shares of the ODS into
+// the given io.Writer, the same way as WriteODS does.
+func writeODSCAR(root *share.Root, shares []share.Share, w io.Writer) error {
+	rootCids, err := axisRootsToCids(root.RowRoots, root.ColumnRoots)
+	if err != nil {
+		return fmt.Errorf("getting root cids: %w", err)
+	}
+
+	err = car.WriteHeader(&car.CarHeader{
+		Roots:   rootCids,
+		Version: 1,
+	}, w)
+	if err != nil {
+		return fmt.Errorf("share: writing carv1 header: %w", err)
+	}
+
+	hasher := nmt.NewNmtHasher(sha256.New(), share.NamespaceSize, ipld.NMTIgnoreMaxNamespace)
+	for _, shr := range shares {
+		if err := writeLeaf(hasher, prependNamespace(0, shr), w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package eds
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/filecoin-project/dagstore"
+	"github.com/filecoin-project/dagstore/shard"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+func TestODSStorage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	params := DefaultParameters()
+	params.Storage = ODSStorage
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	edsStore, err := NewStore(params, t.TempDir(), ds)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, edsStore.Stop(ctx))
+	})
+
+	ods, ok := edsStore.storage.(*odsStorage)
+	require.True(t, ok)
+
+	t.Run("Put and Get", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+
+		has, err := edsStore.Has(ctx, dah.Hash())
+		require.NoError(t, err)
+		require.False(t, has)
+
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.ErrorIs(t, err, dagstore.ErrShardExists)
+
+		has, err = edsStore.Has(ctx, dah.Hash())
+		require.NoError(t, err)
+		require.True(t, has)
+
+		// only the ODS is stored, which is a quarter of the shares of the EDS
+		stat, err := os.Stat(ods.path + dah.String())
+		require.NoError(t, err)
+		odsWidth := int(eds.Width() / 2)
+		assert.EqualValues(t, odsFileHeaderSize(odsWidth)+odsWidth*odsWidth*share.Size, stat.Size())
+
+		got, err := edsStore.Get(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, eds.Equals(got))
+
+		root, err := edsStore.GetDAH(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, dah.Equals(root))
+	})
+
+	t.Run("GetCAR", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// ensure the CAR file is serialized from the ODS file, rather than served from the cache
+		err = edsStore.cache.Load().Remove(shard.KeyFromString(dah.String()))
+		require.NoError(t, err)
+
+		r, err := edsStore.GetCAR(ctx, dah.Hash())
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, r.Close())
+		}()
+
+		got, err := ReadEDS(ctx, r, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, eds.Equals(got))
+	})
+
+	t.Run("Blockstore", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		carBS, err := edsStore.CARBlockstore(ctx, dah.Hash())
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, carBS.Close())
+		}()
+
+		// the recomputed CAR file contains the shares of all the quadrants and all the NMT proofs
+		ac, err := ods.accessor(ctx, dah.Hash())
+		require.NoError(t, err)
+		carReader, err := car.NewCarReader(ac.Reader())
+		require.NoError(t, err)
+
+		var blocks int
+		for {
+			next, err := carReader.Next()
+			if err != nil {
+				require.ErrorIs(t, err, io.EOF)
+				break
+			}
+			blocks++
+
+			for _, bs := range []dagstore.ReadBlockstore{edsStore.Blockstore(), carBS} {
+				block, err := bs.Get(ctx, next.Cid())
+				require.NoError(t, err)
+				assert.Equal(t, next.RawData(), block.RawData())
+			}
+		}
+		width := int(eds.Width())
+		assert.Equal(t, width*width+2*width*(width-1), blocks)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		ac, err := ods.accessor(ctx, dah.Hash())
+		require.NoError(t, err)
+		carReader, err := car.NewCarReader(ac.Reader())
+		require.NoError(t, err)
+		block, err := carReader.Next()
+		require.NoError(t, err)
+
+		err = edsStore.Remove(ctx, dah.Hash())
+		require.NoError(t, err)
+
+		has, err := edsStore.Has(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		_, err = os.Stat(ods.path + dah.String())
+		assert.ErrorIs(t, err, os.ErrNotExist)
+
+		// the index entries of the square are dropped
+		has, err = edsStore.Blockstore().Has(ctx, block.Cid())
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		_, err = edsStore.Get(ctx, dah.Hash())
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = edsStore.CARBlockstore(ctx, dah.Hash())
+		assert.ErrorIs(t, err, ErrNotFound)
+		err = edsStore.Remove(ctx, dah.Hash())
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		hashes, err := edsStore.List()
+		require.NoError(t, err)
+		// the squares of the Put and Get, GetCAR and Blockstore cases
+		assert.Len(t, hashes, 3)
+		for _, hash := range hashes {
+			has, err := edsStore.Has(ctx, hash)
+			require.NoError(t, err)
+			assert.True(t, has)
+		}
+	})
+}
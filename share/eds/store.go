@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -45,6 +47,8 @@ var ErrNotFound = errors.New("eds not found in store")
 type Store struct {
 	cancel context.CancelFunc
 
+	storage accessorStorage
+
 	dgstr  *dagstore.DAGStore
 	mounts *mount.Registry
 
@@ -52,10 +56,15 @@ type Store struct {
 	cache atomic.Pointer[cache.DoubleCache]
 
 	carIdx      index.FullIndexRepo
-	invertedIdx *simpleInvertedIndex
+	invertedIdx *invertedIndex
+	// migrationDone is closed once the migration of the legacy inverted index is stopped or done.
+	migrationDone chan struct{}
 
 	basepath   string
 	gcInterval time.Duration
+	// coldPath is the base path of the cold storage tier, if enabled.
+	coldPath string
+	coldAge  time.Duration
 	// lastGCResult is only stored on the store for testing purposes.
 	lastGCResult atomic.Pointer[dagstore.GCResult]
 
@@ -86,12 +95,21 @@ func NewStore(params *Parameters, basePath string, ds datastore.Batching) (*Stor
 		return nil, fmt.Errorf("failed to register FS mount on the registry: %w", err)
 	}
 
+	coldPath := params.ColdStoragePath
+	if coldPath != "" {
+		// the links to the cold storage tier have to point to an absolute path
+		coldPath, err = filepath.Abs(coldPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve cold storage path: %w", err)
+		}
+	}
+
 	fsRepo, err := index.NewFSRepo(basePath + indexPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create index repository: %w", err)
 	}
 
-	invertedIdx, err := newSimpleInvertedIndex(basePath)
+	invertedIdx, err := newInvertedIndex(basePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create index: %w", err)
 	}
@@ -111,12 +129,14 @@ func NewStore(params *Parameters, basePath string, ds datastore.Batching) (*Stor
 		return nil, fmt.Errorf("failed to create DAGStore: %w", err)
 	}
 
-	recentBlocksCache, err := cache.NewAccessorCache("recent", params.RecentBlocksCacheSize)
+	recentBlocksCache, err := cache.NewAccessorCache("recent", params.RecentBlocksCacheSize,
+		append(params.cacheOptions(), cache.WithMaxBytes(params.RecentBlocksCacheBytes))...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create recent blocks cache: %w", err)
 	}
 
-	blockstoreCache, err := cache.NewAccessorCache("blockstore", params.BlockstoreCacheSize)
+	blockstoreCache, err := cache.NewAccessorCache("blockstore", params.BlockstoreCacheSize,
+		append(params.cacheOptions(), cache.WithMaxBytes(params.BlockstoreCacheBytes))...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create blockstore cache: %w", err)
 	}
@@ -127,11 +147,23 @@ func NewStore(params *Parameters, basePath string, ds datastore.Batching) (*Stor
 		carIdx:        fsRepo,
 		invertedIdx:   invertedIdx,
 		gcInterval:    params.GCInterval,
+		coldPath:      coldPath,
+		coldAge:       params.ColdStorageAge,
 		mounts:        r,
 		shardFailures: failureChan,
 	}
 	store.bs = newBlockstore(store, ds)
 	store.cache.Store(cache.NewDoubleCache(recentBlocksCache, blockstoreCache))
+
+	switch params.Storage {
+	case ODSStorage:
+		store.storage, err = newODSStorage(store, basePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ODS storage: %w", err)
+		}
+	default:
+		store.storage = &carStorage{store: store}
+	}
 	return store, nil
 }
 
@@ -152,13 +184,26 @@ func (s *Store) Start(ctx context.Context) error {
 		go s.gc(runCtx)
 	}
 
+	if s.coldPath != "" {
+		go s.runColdStorage(runCtx)
+	}
+
 	go s.watchForFailures(runCtx)
+
+	s.migrationDone = make(chan struct{})
+	if s.invertedIdx.migrating() {
+		go s.migrateInvertedIndex(runCtx)
+	} else {
+		close(s.migrationDone)
+	}
 	return nil
 }
 
 // Stop stops the underlying DAGStore.
 func (s *Store) Stop(context.Context) error {
-	defer s.cancel()
+	s.cancel()
+	// the migration has to stop using the inverted index before it's closed
+	<-s.migrationDone
 	if err := s.invertedIdx.close(); err != nil {
 		return err
 	}
@@ -185,6 +230,69 @@ func (s *Store) gc(ctx context.Context) {
 	}
 }
 
+// migrateInvertedIndex adds all the stored EDSes to the inverted index and drops the legacy one.
+// Lookups fall back to the legacy index until the migration is done. The EDSes are migrated in the
+// order of their DataHashes and the last migrated one is persisted, so that an interrupted
+// migration resumes after it on the next start.
+func (s *Store) migrateInvertedIndex(ctx context.Context) {
+	defer close(s.migrationDone)
+
+	roots, err := s.List()
+	if err != nil {
+		log.Errorw("listing EDSes to migrate inverted index", "err", err)
+		return
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return bytes.Compare(roots[i], roots[j]) < 0
+	})
+
+	last, err := s.invertedIdx.lastMigrated(ctx)
+	if err != nil {
+		log.Errorw("getting inverted index migration progress", "err", err)
+		return
+	}
+	if last != nil {
+		// EDSes stored since the migration started are added to the inverted index by Put
+		skip := sort.Search(len(roots), func(i int) bool {
+			return bytes.Compare(roots[i], last) > 0
+		})
+		log.Infow("resuming inverted index migration", "migrated", skip, "amount", len(roots))
+		roots = roots[skip:]
+	}
+
+	log.Infow("migrating inverted index", "amount", len(roots))
+	for i, root := range roots {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err := s.storage.addToIndex(ctx, root)
+		if err != nil {
+			// the EDS could be removed after it was listed
+			if has, hasErr := s.Has(ctx, root); hasErr == nil && !has {
+				continue
+			}
+			if ctx.Err() == nil {
+				log.Errorw("migrating inverted index", "root", root.String(), "err", err)
+			}
+			return
+		}
+		if err := s.invertedIdx.setLastMigrated(ctx, root); err != nil {
+			log.Errorw("persisting inverted index migration progress", "root", root.String(), "err", err)
+			return
+		}
+		if (i+1)%1000 == 0 {
+			log.Infow("migrating inverted index", "migrated", i+1, "amount", len(roots))
+		}
+	}
+
+	if err := s.invertedIdx.dropLegacy(ctx); err != nil {
+		log.Errorw("dropping legacy inverted index", "err", err)
+		return
+	}
+	log.Info("migrated inverted index")
+}
+
 func (s *Store) watchForFailures(ctx context.Context) {
 	for {
 		select {
@@ -205,15 +313,15 @@ func (s *Store) watchForFailures(ctx context.Context) {
 // Put stores the given data square with DataRoot's hash as a key.
 //
 // The square is verified on the Exchange level, and Put only stores the square, trusting it.
-// The resulting file stores all the shares and NMT Merkle Proofs of the EDS.
-// Additionally, the file gets indexed s.t. store.Blockstore can access them.
+// The square is persisted by the Storage configured for the Store. Additionally, the shares and
+// NMT Merkle Proofs of the EDS get indexed s.t. store.Blockstore can access them.
 func (s *Store) Put(ctx context.Context, root share.DataHash, square *rsmt2d.ExtendedDataSquare) error {
 	ctx, span := tracer.Start(ctx, "store/put", trace.WithAttributes(
 		attribute.Int("width", int(square.Width())),
 	))
 
 	tnow := time.Now()
-	err := s.put(ctx, root, square)
+	err := s.storage.Put(ctx, root, square)
 	result := putOK
 	switch {
 	case errors.Is(err, dagstore.ErrShardExists):
@@ -339,8 +447,11 @@ func trackLateResult(opName string, res <-chan dagstore.ShardResult, metrics *me
 func (s *Store) GetCAR(ctx context.Context, root share.DataHash) (io.ReadCloser, error) {
 	ctx, span := tracer.Start(ctx, "store/get-car")
 	tnow := time.Now()
-	r, err := s.getCAR(ctx, root)
+	r, err := s.storage.GetCAR(ctx, root)
 	s.metrics.observeGetCAR(ctx, time.Since(tnow), err != nil)
+	if err == nil {
+		s.observeTierAccess(ctx, "get_car", root)
+	}
 	utils.SetStatusAndEnd(span, err)
 	return r, err
 }
@@ -370,6 +481,11 @@ func (s *Store) Blockstore() bstore.Blockstore {
 	return s.bs
 }
 
+// CacheStats returns the contents and the hit ratios of the accessor caches of the Store.
+func (s *Store) CacheStats() []cache.Stats {
+	return s.cache.Load().Stats()
+}
+
 // CARBlockstore returns an IPFS Blockstore providing access to individual shares/nodes of a
 // specific EDS identified by DataHash and registered on the Store. NOTE: The Blockstore does not
 // store whole Celestia Blocks but IPFS blocks. We represent `shares` and NMT Merkle proofs as IPFS
@@ -380,8 +496,11 @@ func (s *Store) CARBlockstore(
 ) (*BlockstoreCloser, error) {
 	ctx, span := tracer.Start(ctx, "store/car-blockstore")
 	tnow := time.Now()
-	cbs, err := s.carBlockstore(ctx, root)
+	cbs, err := s.storage.CARBlockstore(ctx, root)
 	s.metrics.observeCARBlockstore(ctx, time.Since(tnow), err != nil)
+	if err == nil {
+		s.observeTierAccess(ctx, "car_blockstore", root)
+	}
 	utils.SetStatusAndEnd(span, err)
 	return cbs, err
 }
@@ -408,7 +527,7 @@ func (s *Store) carBlockstore(
 func (s *Store) GetDAH(ctx context.Context, root share.DataHash) (*share.Root, error) {
 	ctx, span := tracer.Start(ctx, "store/car-dah")
 	tnow := time.Now()
-	r, err := s.getDAH(ctx, root)
+	r, err := s.storage.GetDAH(ctx, root)
 	s.metrics.observeGetDAH(ctx, time.Since(tnow), err != nil)
 	utils.SetStatusAndEnd(span, err)
 	return r, err
@@ -461,25 +580,44 @@ func (s *Store) getAccessor(ctx context.Context, key shard.Key) (cache.Accessor,
 		if res.Error != nil {
 			return nil, fmt.Errorf("failed to acquire shard: %w", res.Error)
 		}
-		return res.Accessor, nil
+		// the caches limited by bytes account for the size of the CAR file
+		info, err := os.Stat(s.basepath + blocksPath + key.String())
+		if err != nil {
+			return res.Accessor, nil
+		}
+		return &sizedAccessor{ShardAccessor: res.Accessor, size: info.Size()}, nil
 	case <-ctx.Done():
 		go trackLateResult("get_shard", ch, s.metrics, time.Minute)
 		return nil, ctx.Err()
 	}
 }
 
+// sizedAccessor is the accessor of a shard reporting the size of its CAR file.
+type sizedAccessor struct {
+	*dagstore.ShardAccessor
+	size int64
+}
+
+func (a *sizedAccessor) Size() int64 {
+	return a.size
+}
+
 // Remove removes EDS from Store by the given share.Root hash and cleans up all
 // the indexing.
 func (s *Store) Remove(ctx context.Context, root share.DataHash) error {
 	ctx, span := tracer.Start(ctx, "store/remove")
 	tnow := time.Now()
-	err := s.remove(ctx, root)
+	err := s.storage.Remove(ctx, root)
 	s.metrics.observeRemove(ctx, time.Since(tnow), err != nil)
 	utils.SetStatusAndEnd(span, err)
 	return err
 }
 
 func (s *Store) remove(ctx context.Context, root share.DataHash) (err error) {
+	lk := &s.stripedLocks[root[len(root)-1]]
+	lk.Lock()
+	defer lk.Unlock()
+
 	key := shard.KeyFromString(root.String())
 	// remove open links to accessor from cache
 	if err := s.cache.Load().Remove(key); err != nil {
@@ -501,6 +639,12 @@ func (s *Store) remove(ctx context.Context, root share.DataHash) (err error) {
 		return ctx.Err()
 	}
 
+	// the full index is needed to find the inverted index entries of the shard, so those have to be
+	// cleaned up before the full index is dropped
+	if err := s.dropInvertedIndex(ctx, key); err != nil {
+		log.Warnw("failed to drop inverted index entries", "key", key, "err", err)
+	}
+
 	dropped, err := s.carIdx.DropFullIndex(key)
 	if !dropped {
 		log.Warnf("failed to drop index for %s", key)
@@ -509,13 +653,45 @@ func (s *Store) remove(ctx context.Context, root share.DataHash) (err error) {
 		return fmt.Errorf("failed to drop index for %s: %w", key, err)
 	}
 
-	err = os.Remove(s.basepath + blocksPath + root.String())
+	err = removeFile(s.basepath + blocksPath + root.String())
 	if err != nil {
 		return fmt.Errorf("failed to remove CAR file: %w", err)
 	}
 	return nil
 }
 
+// addInvertedIndex points the inverted index entries of all the multihashes of the shard to it.
+func (s *Store) addInvertedIndex(ctx context.Context, key shard.Key) error {
+	mhIter, err := s.fullIndexIterator(key)
+	if err != nil {
+		return err
+	}
+	return s.invertedIdx.AddMultihashesForShard(ctx, mhIter, key)
+}
+
+// dropInvertedIndex removes all the inverted index entries that point to the shard.
+func (s *Store) dropInvertedIndex(ctx context.Context, key shard.Key) error {
+	mhIter, err := s.fullIndexIterator(key)
+	if err != nil {
+		return err
+	}
+	return s.invertedIdx.dropMultihashesForShard(ctx, mhIter, key)
+}
+
+// fullIndexIterator iterates over the multihashes of the shard in its full index.
+func (s *Store) fullIndexIterator(key shard.Key) (*mhIterator, error) {
+	idx, err := s.carIdx.GetFullIndex(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get full index: %w", err)
+	}
+
+	iterable, ok := idx.(iterableIndex)
+	if !ok {
+		return nil, fmt.Errorf("full index of type %T is not iterable", idx)
+	}
+	return &mhIterator{idx: iterable}, nil
+}
+
 // Get reads EDS out of Store by given DataRoot.
 //
 // It reads only one quadrant(1/4) of the EDS and verifies the integrity of the stored data by
@@ -523,8 +699,11 @@ func (s *Store) remove(ctx context.Context, root share.DataHash) (err error) {
 func (s *Store) Get(ctx context.Context, root share.DataHash) (*rsmt2d.ExtendedDataSquare, error) {
 	ctx, span := tracer.Start(ctx, "store/get")
 	tnow := time.Now()
-	eds, err := s.get(ctx, root)
+	eds, err := s.storage.Get(ctx, root)
 	s.metrics.observeGet(ctx, time.Since(tnow), err != nil)
+	if err == nil {
+		s.observeTierAccess(ctx, "get", root)
+	}
 	utils.SetStatusAndEnd(span, err)
 	return eds, err
 }
@@ -552,7 +731,7 @@ func (s *Store) get(ctx context.Context, root share.DataHash) (eds *rsmt2d.Exten
 func (s *Store) Has(ctx context.Context, root share.DataHash) (has bool, err error) {
 	ctx, span := tracer.Start(ctx, "store/has")
 	tnow := time.Now()
-	eds, err := s.has(ctx, root)
+	eds, err := s.storage.Has(ctx, root)
 	s.metrics.observeHas(ctx, time.Since(tnow), err != nil)
 	utils.SetStatusAndEnd(span, err)
 	return eds, err
@@ -575,7 +754,7 @@ func (s *Store) has(_ context.Context, root share.DataHash) (bool, error) {
 func (s *Store) List() ([]share.DataHash, error) {
 	ctx, span := tracer.Start(context.Background(), "store/list")
 	tnow := time.Now()
-	hashes, err := s.list()
+	hashes, err := s.storage.List()
 	s.metrics.observeList(ctx, time.Since(tnow), err != nil)
 	utils.SetStatusAndEnd(span, err)
 	return hashes, err
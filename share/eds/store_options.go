@@ -3,6 +3,19 @@ package eds
 import (
 	"fmt"
 	"time"
+
+	"github.com/celestiaorg/celestia-node/share/eds/cache"
+)
+
+// StorageType is the type of the Storage persisting the EDSes of the Store.
+type StorageType string
+
+const (
+	// CARStorage keeps whole EDSes as CARv1 files registered on the DAGStore.
+	CARStorage StorageType = "car"
+	// ODSStorage keeps only the original quadrants of EDSes in compact files and recomputes the
+	// parity on read.
+	ODSStorage StorageType = "ods"
 )
 
 type Parameters struct {
@@ -16,6 +29,52 @@ type Parameters struct {
 
 	// BlockstoreCacheSize is the size of the cache for blockstore requested accessors.
 	BlockstoreCacheSize int
+
+	// RecentBlocksCacheBytes additionally limits the total size of the accessors in the cache for
+	// recent blocks. Zero disables the limit.
+	RecentBlocksCacheBytes int64
+
+	// BlockstoreCacheBytes additionally limits the total size of the accessors in the cache for
+	// blockstore requested accessors. Zero disables the limit.
+	BlockstoreCacheBytes int64
+
+	// CachePolicy is the eviction policy of the accessor caches. Empty policy, as in the configs
+	// written before the policy was introduced, is set to the LRUPolicy on validation.
+	CachePolicy cache.PolicyType
+
+	// CacheTTL is the time accessors are served from the caches for with the TTL cache policy.
+	CacheTTL time.Duration
+
+	// RetentionHeights is the amount of most recent heights whose EDSes are kept in the store. EDSes
+	// of older heights become eligible for pruning. Zero disables the height-based window.
+	RetentionHeights uint64
+
+	// RetentionPeriod is the maximum age of a block, measured by its header time, for which the EDS
+	// is kept in the store. EDSes of older blocks become eligible for pruning. Zero disables the
+	// age-based window.
+	RetentionPeriod time.Duration
+
+	// PruningInterval is the period of time between two consecutive pruning rounds. It is only used
+	// if at least one of the retention windows is set.
+	PruningInterval time.Duration
+
+	// Storage is the type of the Storage persisting the EDSes. Empty type, as in the configs written
+	// before the type was introduced, is the CARStorage.
+	Storage StorageType
+
+	// IntegrityScanInterval is the period of time between two consecutive integrity scans of all
+	// the stored EDSes. The first scan runs on start. Every scan reads and recomputes all the
+	// stored EDSes, so scanning is disabled by zero, the default.
+	IntegrityScanInterval time.Duration
+
+	// ColdStoragePath is the directory, usually on a cheaper mount, EDSes are moved to once they
+	// are stored for longer than ColdStorageAge. Moved EDSes are still served through links left
+	// in the base path. Empty path disables the cold storage tier.
+	ColdStoragePath string
+
+	// ColdStorageAge is the time an EDS is kept in the base path after it's stored, before it's
+	// moved to the cold storage tier.
+	ColdStorageAge time.Duration
 }
 
 // DefaultParameters returns the default configuration values for the EDS store parameters.
@@ -24,6 +83,15 @@ func DefaultParameters() *Parameters {
 		GCInterval:            0,
 		RecentBlocksCacheSize: 10,
 		BlockstoreCacheSize:   128,
+		CachePolicy:           cache.LRUPolicy,
+		CacheTTL:              time.Minute * 10,
+		RetentionHeights:      0,
+		RetentionPeriod:       0,
+		PruningInterval:       time.Minute * 5,
+		Storage:               CARStorage,
+		IntegrityScanInterval: 0,
+		ColdStoragePath:       "",
+		ColdStorageAge:        time.Hour * 24 * 7,
 	}
 }
 
@@ -39,5 +107,60 @@ func (p *Parameters) Validate() error {
 	if p.BlockstoreCacheSize < 1 {
 		return fmt.Errorf("eds: blockstore cache size must be positive")
 	}
+
+	if p.RecentBlocksCacheBytes < 0 {
+		return fmt.Errorf("eds: recent blocks cache bytes cannot be negative")
+	}
+
+	if p.BlockstoreCacheBytes < 0 {
+		return fmt.Errorf("eds: blockstore cache bytes cannot be negative")
+	}
+
+	if p.CachePolicy == "" {
+		p.CachePolicy = cache.LRUPolicy
+	}
+	if err := p.CachePolicy.Validate(); err != nil {
+		return fmt.Errorf("eds: %w", err)
+	}
+
+	if p.CachePolicy == cache.TTLPolicy && p.CacheTTL <= 0 {
+		return fmt.Errorf("eds: cache TTL must be positive")
+	}
+
+	if p.RetentionPeriod < 0 {
+		return fmt.Errorf("eds: retention period cannot be negative")
+	}
+
+	if p.PruningEnabled() && p.PruningInterval <= 0 {
+		return fmt.Errorf("eds: pruning interval must be positive")
+	}
+
+	if p.IntegrityScanInterval < 0 {
+		return fmt.Errorf("eds: integrity scan interval cannot be negative")
+	}
+
+	if p.ColdStorageAge < 0 {
+		return fmt.Errorf("eds: cold storage age cannot be negative")
+	}
+
+	if p.ColdStoragePath != "" && p.ColdStorageAge == 0 {
+		return fmt.Errorf("eds: cold storage age must be positive")
+	}
+
+	switch p.Storage {
+	case "", CARStorage, ODSStorage:
+	default:
+		return fmt.Errorf("eds: unknown storage type %q", p.Storage)
+	}
 	return nil
 }
+
+// cacheOptions returns the options shared by the accessor caches.
+func (p *Parameters) cacheOptions() []cache.Option {
+	return []cache.Option{cache.WithPolicy(p.CachePolicy), cache.WithTTL(p.CacheTTL)}
+}
+
+// PruningEnabled reports whether any retention window is configured.
+func (p *Parameters) PruningEnabled() bool {
+	return p.RetentionHeights > 0 || p.RetentionPeriod > 0
+}
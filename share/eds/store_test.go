@@ -1,9 +1,11 @@
 package eds
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -382,6 +384,51 @@ func Test_CachedAccessor(t *testing.T) {
 	require.Equal(t, firstBlock, secondBlock)
 }
 
+// Test_CacheStats verifies that the caches limited by bytes account for the size of the stored
+// EDSes and report their contents.
+func Test_CacheStats(t *testing.T) {
+	for _, storage := range []StorageType{CARStorage, ODSStorage} {
+		t.Run(string(storage), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			t.Cleanup(cancel)
+
+			params := DefaultParameters()
+			params.Storage = storage
+			params.CachePolicy = cache.LFUPolicy
+			params.RecentBlocksCacheBytes = 1 << 30
+			edsStore, err := NewStore(params, t.TempDir(), ds_sync.MutexWrap(datastore.NewMapDatastore()))
+			require.NoError(t, err)
+			require.NoError(t, edsStore.Start(ctx))
+			t.Cleanup(func() {
+				require.NoError(t, edsStore.Stop(ctx))
+			})
+
+			eds, dah := randomEDS(t)
+			require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+			// accessor will be registered in cache async on put, so give it some time to settle
+			time.Sleep(time.Millisecond * 100)
+
+			r, err := edsStore.GetCAR(ctx, dah.Hash())
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+
+			stats := edsStore.CacheStats()
+			require.Len(t, stats, 2)
+			recent := stats[0]
+			assert.Equal(t, "recent", recent.Name)
+			assert.Equal(t, cache.LFUPolicy, recent.Policy)
+			assert.EqualValues(t, 1<<30, recent.MaxBytes)
+			assert.EqualValues(t, 1, recent.Hits)
+			require.Len(t, recent.Entries, 1)
+			assert.Equal(t, dah.String(), recent.Entries[0].Key)
+			assert.Positive(t, recent.Entries[0].Size)
+			assert.Equal(t, recent.Entries[0].Size, recent.Bytes)
+			assert.Equal(t, "blockstore", stats[1].Name)
+			assert.Empty(t, stats[1].Entries)
+		})
+	}
+}
+
 // Test_CachedAccessor verifies that the reader represented by a accessor obtained directly from
 // dagstore can be read from multiple times, without exhausting the underlying reader.
 func Test_NotCachedAccessor(t *testing.T) {
@@ -537,3 +584,117 @@ func randomEDS(t *testing.T) (*rsmt2d.ExtendedDataSquare, *share.Root) {
 
 	return eds, dah
 }
+
+// TestEDSStore_MigrateInvertedIndex ensures that the EDSes of stores with the legacy inverted index
+// are added to the new one on start.
+func TestEDSStore_MigrateInvertedIndex(t *testing.T) {
+	for _, storage := range []StorageType{CARStorage, ODSStorage} {
+		t.Run(string(storage), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			t.Cleanup(cancel)
+
+			params := DefaultParameters()
+			params.Storage = storage
+			dir := t.TempDir()
+			ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+			edsStore, err := NewStore(params, dir, ds)
+			require.NoError(t, err)
+			require.NoError(t, edsStore.Start(ctx))
+
+			var cids []cid.Cid
+			for i := 0; i < 3; i++ {
+				eds, dah := randomEDS(t)
+				require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+				for _, root := range dah.RowRoots {
+					cids = append(cids, ipld.MustCidFromNamespacedSha256(root))
+				}
+			}
+			require.NoError(t, edsStore.Stop(ctx))
+
+			// replace the index with an empty legacy one
+			require.NoError(t, os.RemoveAll(dir+invertedIndexPath))
+			legacy, err := newSimpleInvertedIndex(dir)
+			require.NoError(t, err)
+			require.NoError(t, legacy.close())
+
+			edsStore, err = NewStore(params, dir, ds)
+			require.NoError(t, err)
+			require.NoError(t, edsStore.Start(ctx))
+			t.Cleanup(func() {
+				require.NoError(t, edsStore.Stop(ctx))
+			})
+
+			select {
+			case <-edsStore.migrationDone:
+			case <-ctx.Done():
+				t.Fatal(ctx.Err())
+			}
+			require.False(t, edsStore.invertedIdx.migrating())
+			require.NoDirExists(t, dir+legacyInvertedIndexPath)
+
+			for _, c := range cids {
+				has, err := edsStore.Blockstore().Has(ctx, c)
+				require.NoError(t, err)
+				require.True(t, has)
+			}
+		})
+	}
+}
+
+// TestEDSStore_ResumeInvertedIndexMigration ensures that an interrupted migration of the legacy
+// inverted index only adds the EDSes following the last migrated one.
+func TestEDSStore_ResumeInvertedIndexMigration(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	dir := t.TempDir()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	edsStore, err := NewStore(DefaultParameters(), dir, ds)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Start(ctx))
+
+	dahs := make([]*share.Root, 3)
+	for i := range dahs {
+		var eds *rsmt2d.ExtendedDataSquare
+		eds, dahs[i] = randomEDS(t)
+		require.NoError(t, edsStore.Put(ctx, dahs[i].Hash(), eds))
+	}
+	require.NoError(t, edsStore.Stop(ctx))
+	sort.Slice(dahs, func(i, j int) bool {
+		return bytes.Compare(dahs[i].Hash(), dahs[j].Hash()) < 0
+	})
+
+	// replace the index with the one of a migration interrupted after the first two EDSes, whose
+	// entries are kept by the legacy index only
+	require.NoError(t, os.RemoveAll(dir+invertedIndexPath))
+	legacy, err := newSimpleInvertedIndex(dir)
+	require.NoError(t, err)
+	require.NoError(t, legacy.close())
+	invertedIdx, err := newInvertedIndex(dir)
+	require.NoError(t, err)
+	require.NoError(t, invertedIdx.setLastMigrated(ctx, dahs[1].Hash()))
+	require.NoError(t, invertedIdx.close())
+
+	edsStore, err = NewStore(DefaultParameters(), dir, ds)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, edsStore.Stop(ctx))
+	})
+
+	select {
+	case <-edsStore.migrationDone:
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+	require.False(t, edsStore.invertedIdx.migrating())
+	last, err := edsStore.invertedIdx.lastMigrated(ctx)
+	require.NoError(t, err)
+	require.Nil(t, last)
+
+	for i, dah := range dahs {
+		has, err := edsStore.Blockstore().Has(ctx, ipld.MustCidFromNamespacedSha256(dah.RowRoots[0]))
+		require.NoError(t, err)
+		require.Equal(t, i == 2, has)
+	}
+}
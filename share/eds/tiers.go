@@ -0,0 +1,165 @@
+package eds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+const (
+	hotTier  = "hot"
+	coldTier = "cold"
+
+	// coldStorageInterval is the period of time between two consecutive checks for EDSes to be
+	// moved to the cold storage tier.
+	coldStorageInterval = time.Minute * 10
+)
+
+// runColdStorage periodically moves EDSes that are old enough to the cold storage tier.
+func (s *Store) runColdStorage(ctx context.Context) {
+	ticker := time.NewTicker(coldStorageInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.moveToColdTier(ctx); err != nil && ctx.Err() == nil {
+				log.Errorw("moving EDSes to cold storage", "err", err)
+			}
+		}
+	}
+}
+
+// moveToColdTier moves the files of all the EDSes that are stored for longer than the cold storage
+// age from the base path to the cold storage path. Each moved file is replaced with a link to its
+// new location, so the EDS is served from either tier transparently.
+func (s *Store) moveToColdTier(ctx context.Context) error {
+	roots, err := s.List()
+	if err != nil {
+		return fmt.Errorf("listing EDSes: %w", err)
+	}
+
+	var moved int
+	for _, root := range roots {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		ok, err := s.moveToCold(root)
+		if err != nil {
+			log.Errorw("moving EDS to cold storage", "root", root.String(), "err", err)
+			continue
+		}
+		if ok {
+			moved++
+			s.metrics.observeColdMove(ctx)
+		}
+	}
+
+	if moved > 0 {
+		log.Infow("moved EDSes to cold storage", "amount", moved)
+	}
+	return nil
+}
+
+// moveToCold moves the file of the EDS to the cold storage tier if it's old enough. It reports
+// whether the file was moved.
+func (s *Store) moveToCold(root share.DataHash) (bool, error) {
+	lk := &s.stripedLocks[root[len(root)-1]]
+	lk.Lock()
+	defer lk.Unlock()
+
+	hotPath := s.storage.filePath(root)
+	info, err := os.Lstat(hotPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		// the EDS was removed after it was listed
+		return false, nil
+	case err != nil:
+		return false, err
+	case !info.Mode().IsRegular(), time.Since(info.ModTime()) < s.coldAge:
+		// already moved or too recent
+		return false, nil
+	}
+
+	coldPath := s.coldPath + strings.TrimPrefix(hotPath, s.basepath)
+	if err := os.MkdirAll(filepath.Dir(coldPath), os.ModePerm); err != nil {
+		return false, fmt.Errorf("creating cold storage directory: %w", err)
+	}
+	// the file is copied under a temporary name first, so that it is never read partially written
+	if err := copyFile(hotPath, coldPath+tmpExt); err != nil {
+		return false, fmt.Errorf("copying file: %w", err)
+	}
+	if err := os.Rename(coldPath+tmpExt, coldPath); err != nil {
+		return false, fmt.Errorf("renaming file: %w", err)
+	}
+
+	// replacing the file with the link by a rename never leaves the base path without the EDS, while
+	// the readers of the replaced file keep reading it until they close it
+	if err := os.Symlink(coldPath, hotPath+tmpExt); err != nil {
+		return false, fmt.Errorf("linking file: %w", err)
+	}
+	if err := os.Rename(hotPath+tmpExt, hotPath); err != nil {
+		return false, fmt.Errorf("replacing file with link: %w", err)
+	}
+	return true, nil
+}
+
+// tier returns the storage tier the EDS is served from.
+func (s *Store) tier(root share.DataHash) string {
+	info, err := os.Lstat(s.storage.filePath(root))
+	if err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return coldTier
+	}
+	return hotTier
+}
+
+func (s *Store) observeTierAccess(ctx context.Context, opName string, root share.DataHash) {
+	// all the EDSes are in the hot tier, unless the cold one is enabled
+	if s.metrics == nil || s.coldPath == "" {
+		return
+	}
+	s.metrics.observeTierAccess(ctx, opName, s.tier(root))
+}
+
+// removeFile removes the file at the path along with the file in the cold storage tier it links
+// to, if any.
+func removeFile(path string) error {
+	target, err := os.Readlink(path)
+	if err == nil {
+		if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return os.Remove(path)
+}
+
+// copyFile copies the file at src path to the dst path and syncs it.
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer closeAndLog("source file", in)
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, out.Close())
+	}()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
@@ -0,0 +1,89 @@
+package eds
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/dagstore/shard"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+func TestStore_ColdStorage(t *testing.T) {
+	for _, storage := range []StorageType{CARStorage, ODSStorage} {
+		t.Run(string(storage), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			t.Cleanup(cancel)
+
+			params := DefaultParameters()
+			params.Storage = storage
+			params.ColdStoragePath = t.TempDir()
+			params.ColdStorageAge = time.Hour
+			ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+			edsStore, err := NewStore(params, t.TempDir(), ds)
+			require.NoError(t, err)
+			require.NoError(t, edsStore.Start(ctx))
+			t.Cleanup(func() {
+				require.NoError(t, edsStore.Stop(ctx))
+			})
+
+			oldEDS, oldDAH := randomEDS(t)
+			require.NoError(t, edsStore.Put(ctx, oldDAH.Hash(), oldEDS))
+			recentEDS, recentDAH := randomEDS(t)
+			require.NoError(t, edsStore.Put(ctx, recentDAH.Hash(), recentEDS))
+
+			// age the file of the first EDS past the cold storage age
+			oldPath := edsStore.storage.filePath(oldDAH.Hash())
+			past := time.Now().Add(-params.ColdStorageAge * 2)
+			require.NoError(t, os.Chtimes(oldPath, past, past))
+
+			require.NoError(t, edsStore.moveToColdTier(ctx))
+			assert.Equal(t, coldTier, edsStore.tier(oldDAH.Hash()))
+			assert.Equal(t, hotTier, edsStore.tier(recentDAH.Hash()))
+			coldPath, err := os.Readlink(oldPath)
+			require.NoError(t, err)
+			assert.FileExists(t, coldPath)
+
+			// moved EDSes stay in the cold storage tier
+			require.NoError(t, edsStore.moveToColdTier(ctx))
+			assert.Equal(t, coldTier, edsStore.tier(oldDAH.Hash()))
+
+			// the moved EDS is served from the cold storage tier rather than the cache
+			key := shard.KeyFromString(oldDAH.String())
+			require.NoError(t, edsStore.cache.Load().Remove(key))
+
+			got, err := edsStore.Get(ctx, oldDAH.Hash())
+			require.NoError(t, err)
+			assert.True(t, oldEDS.Equals(got))
+
+			r, err := edsStore.GetCAR(ctx, oldDAH.Hash())
+			require.NoError(t, err)
+			got, err = ReadEDS(ctx, r, oldDAH.Hash())
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.True(t, oldEDS.Equals(got))
+
+			root := ipld.MustCidFromNamespacedSha256(oldDAH.RowRoots[0])
+			block, err := edsStore.Blockstore().Get(ctx, root)
+			require.NoError(t, err)
+			assert.Equal(t, root, block.Cid())
+
+			// removal cleans up both tiers
+			require.NoError(t, edsStore.Remove(ctx, oldDAH.Hash()))
+			assert.NoFileExists(t, coldPath)
+			_, err = os.Lstat(oldPath)
+			assert.ErrorIs(t, err, os.ErrNotExist)
+
+			hashes, err := edsStore.List()
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []share.DataHash{recentDAH.Hash()}, hashes)
+		})
+	}
+}
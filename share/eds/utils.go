@@ -12,6 +12,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
+
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds/cache"
@@ -65,7 +68,18 @@ func RetrieveNamespaceFromStore(
 	dah *share.Root,
 	namespace share.Namespace,
 ) (shares share.NamespacedShares, err error) {
-	if err = namespace.ValidateForData(); err != nil {
+	return RetrieveNamespaceRangeFromStore(ctx, store, dah, namespace, namespace)
+}
+
+// RetrieveNamespaceRangeFromStore gets all EDS shares within any of the namespaces between min and
+// max, inclusive, from the EDS store through the corresponding CAR-level blockstore.
+func RetrieveNamespaceRangeFromStore(
+	ctx context.Context,
+	store *Store,
+	dah *share.Root,
+	min, max share.Namespace,
+) (shares share.NamespacedShares, err error) {
+	if err = share.ValidateNamespaceRange(min, max); err != nil {
 		return nil, err
 	}
 
@@ -85,7 +99,7 @@ func RetrieveNamespaceFromStore(
 
 	// wrap the read-only CAR blockstore in a getter
 	blockGetter := NewBlockGetter(bs)
-	shares, err = CollectSharesByNamespace(ctx, blockGetter, dah, namespace)
+	shares, err = CollectSharesByNamespaceRange(ctx, blockGetter, dah, min, max)
 	if errors.Is(err, ipld.ErrNodeNotFound) {
 		// IPLD node not found after the index pointed to this shard and the CAR
 		// blockstore has been opened successfully is a strong indicator of
@@ -113,15 +127,27 @@ func CollectSharesByNamespace(
 	bg blockservice.BlockGetter,
 	root *share.Root,
 	namespace share.Namespace,
+) (shares share.NamespacedShares, err error) {
+	return CollectSharesByNamespaceRange(ctx, bg, root, namespace, namespace)
+}
+
+// CollectSharesByNamespaceRange collects NamespaceShares within any of the namespaces between min
+// and max, inclusive, from share.Root.
+func CollectSharesByNamespaceRange(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	root *share.Root,
+	min, max share.Namespace,
 ) (shares share.NamespacedShares, err error) {
 	ctx, span := tracer.Start(ctx, "collect-shares-by-namespace", trace.WithAttributes(
-		attribute.String("namespace", namespace.String()),
+		attribute.String("min_namespace", min.String()),
+		attribute.String("max_namespace", max.String()),
 	))
 	defer func() {
 		utils.SetStatusAndEnd(span, err)
 	}()
 
-	rootCIDs := ipld.FilterRootByNamespace(root, namespace)
+	rootCIDs := ipld.FilterRootByNamespaceRange(root, min, max)
 	if len(rootCIDs) == 0 {
 		return nil, nil
 	}
@@ -132,13 +158,14 @@ func CollectSharesByNamespace(
 		// shadow loop variables, to ensure correct values are captured
 		i, rootCID := i, rootCID
 		errGroup.Go(func() error {
-			row, proof, err := ipld.GetSharesByNamespace(ctx, bg, rootCID, namespace, len(root.RowRoots))
+			row, proof, err := ipld.GetSharesByNamespaceRange(ctx, bg, rootCID, min, max, len(root.RowRoots))
 			shares[i] = share.NamespacedRow{
 				Shares: row,
 				Proof:  proof,
 			}
 			if err != nil {
-				return fmt.Errorf("retrieving shares by namespace %s for row %x: %w", namespace.String(), rootCID, err)
+				return fmt.Errorf("retrieving shares by namespace range %s-%s for row %x: %w",
+					min.String(), max.String(), rootCID, err)
 			}
 			return nil
 		})
@@ -150,3 +177,72 @@ func CollectSharesByNamespace(
 
 	return shares, nil
 }
+
+// RetrieveAxisHalfFromStore gets the first half of the row or column with the given index along
+// with its inclusion proof from the EDS store through the corresponding CAR-level blockstore.
+func RetrieveAxisHalfFromStore(
+	ctx context.Context,
+	store *Store,
+	dah *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisHalf, error) {
+	roots := share.AxisRoots(dah, axis)
+	if idx < 0 || idx >= len(roots) {
+		return nil, share.ErrOutOfBounds
+	}
+
+	bs, err := store.CARBlockstore(ctx, dah.Hash())
+	if errors.Is(err, ErrNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve blockstore from eds store: %w", err)
+	}
+	defer func() {
+		if err := bs.Close(); err != nil {
+			log.Warnw("closing blockstore", "err", err)
+		}
+	}()
+
+	// wrap the read-only CAR blockstore in a getter
+	blockGetter := NewBlockGetter(bs)
+	half, err := ipld.GetAxisHalf(ctx, blockGetter, ipld.MustCidFromNamespacedSha256(roots[idx]), len(roots))
+	if errors.Is(err, ipld.ErrNodeNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s %d from store: %w", axis, idx, err)
+	}
+	return half, nil
+}
+
+// ProveAxisHalf returns the first half of the row or column with the given index of the EDS along
+// with its inclusion proof against the root of the axis.
+func ProveAxisHalf(eds *rsmt2d.ExtendedDataSquare, idx int, axis rsmt2d.Axis) (*share.AxisHalf, error) {
+	width := int(eds.Width())
+	if idx < 0 || idx >= width {
+		return nil, share.ErrOutOfBounds
+	}
+
+	shares := eds.Row(uint(idx))
+	if axis == rsmt2d.Col {
+		shares = eds.Col(uint(idx))
+	}
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(width/2), uint(idx))
+	for _, shr := range shares {
+		if err := tree.Push(shr); err != nil {
+			return nil, fmt.Errorf("building %s %d tree: %w", axis, idx, err)
+		}
+	}
+	proof, err := tree.ProveRange(0, width/2)
+	if err != nil {
+		return nil, fmt.Errorf("proving %s %d: %w", axis, idx, err)
+	}
+	return &share.AxisHalf{
+		Shares: shares[:width/2],
+		Proof:  &proof,
+	}, nil
+}
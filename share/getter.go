@@ -28,6 +28,10 @@ type Getter interface {
 	// GetShare gets a Share by coordinates in EDS.
 	GetShare(ctx context.Context, header *header.ExtendedHeader, row, col int) (Share, error)
 
+	// GetSamples gets the Shares at the coordinates of the given samples in EDS, in the order of
+	// the samples. It fails if any of the shares can't be retrieved.
+	GetSamples(ctx context.Context, header *header.ExtendedHeader, samples []Sample) ([]Share, error)
+
 	// GetEDS gets the full EDS identified by the given extended header.
 	GetEDS(context.Context, *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error)
 
@@ -37,6 +41,21 @@ type Getter interface {
 	// If no shares are found for target namespace non-inclusion could be also verified by calling
 	// Verify method.
 	GetSharesByNamespace(context.Context, *header.ExtendedHeader, Namespace) (NamespacedShares, error)
+
+	// GetSharesByNamespaceRange gets all shares from an EDS within any of the namespaces between min
+	// and max, inclusive.
+	// Shares are returned in a row-by-row order if the namespaces span multiple rows.
+	// Inclusion of returned data, as well as non-inclusion of the range in the rows without shares
+	// in it, could be verified using VerifyRange method on NamespacedShares.
+	GetSharesByNamespaceRange(
+		ctx context.Context, header *header.ExtendedHeader, min, max Namespace,
+	) (NamespacedShares, error)
+
+	// GetRow gets the first half of the row or column with the given index along with its inclusion
+	// proof against the root of the axis.
+	// Inclusion of returned data could be verified using Verify method on AxisHalf, and the whole
+	// axis could be recomputed from it using Extend method.
+	GetRow(ctx context.Context, header *header.ExtendedHeader, idx int, axis rsmt2d.Axis) (*AxisHalf, error)
 }
 
 // NamespacedShares represents all shares with proofs within a specific namespace of an EDS.
@@ -96,3 +115,103 @@ func (row *NamespacedRow) verify(rowRoot []byte, namespace Namespace) bool {
 		rowRoot,
 	)
 }
+
+// VerifyRange validates NamespacedShares of the namespace range between min and max, inclusive, by
+// checking every row with nmt range proof. Besides inclusion, it verifies that no shares of the
+// range were left out of the rows.
+func (ns NamespacedShares) VerifyRange(root *Root, min, max Namespace) error {
+	var originalRoots [][]byte
+	for _, row := range root.RowRoots {
+		if !max.IsBelowMin(row) && !min.IsAboveMax(row) {
+			originalRoots = append(originalRoots, row)
+		}
+	}
+
+	if len(originalRoots) != len(ns) {
+		return fmt.Errorf("amount of rows differs between root and namespace shares: expected %d, got %d",
+			len(originalRoots), len(ns))
+	}
+
+	for i, row := range ns {
+		// verify row data against row hash from original root
+		if err := row.verifyRange(originalRoots[i], min, max); err != nil {
+			return fmt.Errorf("row verification failed: row %d doesn't match original root: %s: %w",
+				i, root.String(), err)
+		}
+	}
+	return nil
+}
+
+// verifyRange validates the row using nmt range proof.
+func (row *NamespacedRow) verifyRange(rowRoot []byte, min, max Namespace) error {
+	if row.Proof == nil || row.Proof.Start() >= row.Proof.End() {
+		return errors.New("empty proof range")
+	}
+	nth := nmt.NewNmtHasher(sha256.New(), NamespaceSize, row.Proof.IsMaxNamespaceIDIgnored())
+
+	// the subtrees outside of the proven leaves must not contain any namespace of the range,
+	// otherwise some of the shares within the range were left out
+	for _, node := range row.Proof.Nodes() {
+		if err := nth.ValidateNodeFormat(node); err != nil {
+			return err
+		}
+		if !max.IsBelowMin(node) && !min.IsAboveMax(node) {
+			return errors.New("proof node is within the namespace range")
+		}
+	}
+
+	if row.Proof.IsOfAbsence() {
+		leafHash := row.Proof.LeafHash()
+		if len(row.Shares) != 0 {
+			return errors.New("shares are provided along with absence proof")
+		}
+		if err := nth.ValidateNodeFormat(leafHash); err != nil {
+			return err
+		}
+		if !max.IsBelowMin(leafHash) && !min.IsAboveMax(leafHash) {
+			return errors.New("absence proof leaf is within the namespace range")
+		}
+		return verifyLeafHashes(nth, *row.Proof, min, [][]byte{leafHash}, rowRoot)
+	}
+
+	if len(row.Shares) != row.Proof.End()-row.Proof.Start() {
+		return fmt.Errorf("amount of shares differs from proof range: expected %d, got %d",
+			row.Proof.End()-row.Proof.Start(), len(row.Shares))
+	}
+	leafHashes := make([][]byte, 0, len(row.Shares))
+	for _, shr := range row.Shares {
+		namespace := GetNamespace(shr)
+		if namespace.IsLess(min) || max.IsLess(namespace) {
+			return fmt.Errorf("share namespace %s is outside of the namespace range", namespace)
+		}
+		// construct nmt leaf from share by prepending namespace
+		leafHash, err := nth.HashLeaf(append(namespace, shr...))
+		if err != nil {
+			return err
+		}
+		leafHashes = append(leafHashes, leafHash)
+	}
+	// nmt only verifies inclusion of leaves of a single namespace, so the proof of the range is
+	// converted to an absence one, which skips the namespace check and is otherwise verified in the
+	// same way. The namespaces of the leaves are checked above instead.
+	proof := nmt.NewAbsenceProof(
+		row.Proof.Start(),
+		row.Proof.End(),
+		row.Proof.Nodes(),
+		leafHashes[0],
+		row.Proof.IsMaxNamespaceIDIgnored(),
+	)
+	return verifyLeafHashes(nth, proof, min, leafHashes, rowRoot)
+}
+
+// verifyLeafHashes checks that the proof with the given leaf hashes hashes up to the row root.
+func verifyLeafHashes(nth *nmt.NmtHasher, proof nmt.Proof, min Namespace, leafHashes [][]byte, rowRoot []byte) error {
+	valid, err := proof.VerifyLeafHashes(nth, false, min.ToNMT(), leafHashes, rowRoot)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.New("proof doesn't match row root")
+	}
+	return nil
+}
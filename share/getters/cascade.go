@@ -55,6 +55,29 @@ func (cg *CascadeGetter) GetShare(
 	return cascadeGetters(ctx, cg.getters, get)
 }
 
+// GetSamples gets the shares at the coordinates of the given samples from any of registered
+// share.Getters in cascading order.
+func (cg *CascadeGetter) GetSamples(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	samples []share.Sample,
+) ([]share.Share, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-samples", trace.WithAttributes(
+		attribute.Int("amount", len(samples)),
+	))
+	defer span.End()
+
+	if err := share.ValidateSamples(samples, len(header.DAH.RowRoots)); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	get := func(ctx context.Context, get share.Getter) ([]share.Share, error) {
+		return get.GetSamples(ctx, header, samples)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
 // GetEDS gets a full EDS from any of registered share.Getters in cascading order.
 func (cg *CascadeGetter) GetEDS(
 	ctx context.Context, header *header.ExtendedHeader,
@@ -88,6 +111,52 @@ func (cg *CascadeGetter) GetSharesByNamespace(
 	return cascadeGetters(ctx, cg.getters, get)
 }
 
+// GetSharesByNamespaceRange gets NamespacedShares of the namespace range from any of registered
+// share.Getters in cascading order.
+func (cg *CascadeGetter) GetSharesByNamespaceRange(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	min, max share.Namespace,
+) (share.NamespacedShares, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-shares-by-namespace-range", trace.WithAttributes(
+		attribute.String("min_namespace", min.String()),
+		attribute.String("max_namespace", max.String()),
+	))
+	defer span.End()
+
+	get := func(ctx context.Context, get share.Getter) (share.NamespacedShares, error) {
+		return get.GetSharesByNamespaceRange(ctx, header, min, max)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
+// GetRow gets the first half of the row or column from any of registered share.Getters in
+// cascading order.
+func (cg *CascadeGetter) GetRow(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisHalf, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-row", trace.WithAttributes(
+		attribute.Int("idx", idx),
+		attribute.String("axis", axis.String()),
+	))
+	defer span.End()
+
+	if idx < 0 || idx >= len(header.DAH.RowRoots) {
+		err := share.ErrOutOfBounds
+		span.RecordError(err)
+		return nil, err
+	}
+	get := func(ctx context.Context, get share.Getter) (*share.AxisHalf, error) {
+		return get.GetRow(ctx, header, idx, axis)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
 // cascade implements a cascading retry algorithm for getting a value from multiple sources.
 // Cascading implies trying the sources one-by-one in the given order with the
 // given interval until either:
@@ -66,6 +66,62 @@ func TestStoreGetter(t *testing.T) {
 		require.ErrorIs(t, err, share.ErrNotFound)
 	})
 
+	t.Run("GetSamples", func(t *testing.T) {
+		randEds, eh := randomEDS(t)
+		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
+		require.NoError(t, err)
+
+		width := int(randEds.Width())
+		samples := []share.Sample{{Row: 0, Col: 0}, {Row: width - 1, Col: 1}, {Row: 1, Col: width - 1}}
+		shares, err := sg.GetSamples(ctx, eh, samples)
+		require.NoError(t, err)
+		require.Len(t, shares, len(samples))
+		for i, s := range samples {
+			assert.Equal(t, randEds.GetCell(uint(s.Row), uint(s.Col)), shares[i])
+		}
+
+		// doesn't panic on indexes too high
+		_, err = sg.GetSamples(ctx, eh, []share.Sample{{Row: width, Col: 0}})
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+
+		// root not found
+		_, eh = randomEDS(t)
+		_, err = sg.GetSamples(ctx, eh, samples)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("GetRow", func(t *testing.T) {
+		randEds, eh := randomEDS(t)
+		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
+		require.NoError(t, err)
+
+		width := int(randEds.Width())
+		for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+			for idx := 0; idx < width; idx++ {
+				half, err := sg.GetRow(ctx, eh, idx, axis)
+				require.NoError(t, err)
+				require.NoError(t, half.Verify(eh.DAH, idx, axis))
+
+				shares, err := half.Extend()
+				require.NoError(t, err)
+				expected := randEds.Row(uint(idx))
+				if axis == rsmt2d.Col {
+					expected = randEds.Col(uint(idx))
+				}
+				assert.Equal(t, expected, shares)
+			}
+		}
+
+		// doesn't panic on indexes too high
+		_, err := sg.GetRow(ctx, eh, width, rsmt2d.Row)
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+
+		// root not found
+		_, eh = randomEDS(t)
+		_, err = sg.GetRow(ctx, eh, 0, rsmt2d.Row)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
 	t.Run("GetEDS", func(t *testing.T) {
 		randEds, eh := randomEDS(t)
 		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
@@ -105,6 +161,42 @@ func TestStoreGetter(t *testing.T) {
 		require.ErrorIs(t, err, share.ErrNotFound)
 	})
 
+	t.Run("GetSharesByNamespaceRange", func(t *testing.T) {
+		randEds, eh := randomEDS(t)
+		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
+		require.NoError(t, err)
+
+		min, max, expected := randomNamespaceRange(t, randEds)
+		shares, err := sg.GetSharesByNamespaceRange(ctx, eh, min, max)
+		require.NoError(t, err)
+		require.NoError(t, shares.VerifyRange(eh.DAH, min, max))
+		assert.Equal(t, expected, shares.Flatten())
+
+		// shares of a narrower range don't prove the whole range
+		second := share.GetNamespace(expected[1])
+		narrowShares, err := sg.GetSharesByNamespaceRange(ctx, eh, second, max)
+		require.NoError(t, err)
+		require.NoError(t, narrowShares.VerifyRange(eh.DAH, second, max))
+		require.Error(t, narrowShares.VerifyRange(eh.DAH, min, max))
+
+		// namespace range not found
+		absentMin, absentMax := absentNamespaceRange(t, randEds)
+		emptyShares, err := sg.GetSharesByNamespaceRange(ctx, eh, absentMin, absentMax)
+		require.NoError(t, err)
+		require.NoError(t, emptyShares.VerifyRange(eh.DAH, absentMin, absentMax))
+		require.Empty(t, emptyShares.Flatten())
+
+		// invalid range
+		_, err = sg.GetSharesByNamespaceRange(ctx, eh, max, min)
+		require.Error(t, err)
+
+		// root not found
+		emptyRoot := da.MinDataAvailabilityHeader()
+		eh.DAH = &emptyRoot
+		_, err = sg.GetSharesByNamespaceRange(ctx, eh, min, max)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
 	t.Run("GetSharesFromNamespace removes corrupted shard", func(t *testing.T) {
 		randEds, namespace, eh := randomEDSWithDoubledNamespace(t, 4)
 		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
@@ -192,6 +284,59 @@ func TestIPLDGetter(t *testing.T) {
 		require.ErrorIs(t, err, share.ErrNotFound)
 	})
 
+	t.Run("GetSamples", func(t *testing.T) {
+		randEds, eh := randomEDS(t)
+		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
+		require.NoError(t, err)
+
+		width := int(randEds.Width())
+		samples := []share.Sample{{Row: 0, Col: 0}, {Row: width - 1, Col: 1}, {Row: 1, Col: width - 1}}
+		shares, err := sg.GetSamples(ctx, eh, samples)
+		require.NoError(t, err)
+		require.Len(t, shares, len(samples))
+		for i, s := range samples {
+			assert.Equal(t, randEds.GetCell(uint(s.Row), uint(s.Col)), shares[i])
+		}
+
+		// doesn't panic on indexes too high
+		_, err = sg.GetSamples(ctx, eh, []share.Sample{{Row: width, Col: 0}})
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+
+		// root not found
+		_, eh = randomEDS(t)
+		_, err = sg.GetSamples(ctx, eh, samples)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("GetRow", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		randEds, eh := randomEDS(t)
+		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
+		require.NoError(t, err)
+
+		width := int(randEds.Width())
+		for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+			for idx := 0; idx < width; idx++ {
+				half, err := sg.GetRow(ctx, eh, idx, axis)
+				require.NoError(t, err)
+				require.NoError(t, half.Verify(eh.DAH, idx, axis))
+				// the proof must not be accepted for any other row or column
+				require.Error(t, half.Verify(eh.DAH, (idx+1)%width, axis))
+			}
+		}
+
+		// doesn't panic on indexes too high
+		_, err := sg.GetRow(ctx, eh, width+1, rsmt2d.Col)
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+
+		// root not found
+		_, eh = randomEDS(t)
+		_, err = sg.GetRow(ctx, eh, 0, rsmt2d.Row)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
 	t.Run("GetEDS", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 		t.Cleanup(cancel)
@@ -238,6 +383,35 @@ func TestIPLDGetter(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, emptyShares.Flatten())
 	})
+
+	t.Run("GetSharesByNamespaceRange", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		randEds, eh := randomEDS(t)
+		err = edsStore.Put(ctx, eh.DAH.Hash(), randEds)
+		require.NoError(t, err)
+
+		min, max, expected := randomNamespaceRange(t, randEds)
+		shares, err := sg.GetSharesByNamespaceRange(ctx, eh, min, max)
+		require.NoError(t, err)
+		require.NoError(t, shares.VerifyRange(eh.DAH, min, max))
+		assert.Equal(t, expected, shares.Flatten())
+
+		// namespace range not found
+		absentMin, absentMax := absentNamespaceRange(t, randEds)
+		emptyShares, err := sg.GetSharesByNamespaceRange(ctx, eh, absentMin, absentMax)
+		require.NoError(t, err)
+		require.NoError(t, emptyShares.VerifyRange(eh.DAH, absentMin, absentMax))
+		require.Empty(t, emptyShares.Flatten())
+
+		// namespace range doesnt exist in root
+		emptyRoot := da.MinDataAvailabilityHeader()
+		eh.DAH = &emptyRoot
+		emptyShares, err = sg.GetSharesByNamespaceRange(ctx, eh, min, max)
+		require.NoError(t, err)
+		require.Empty(t, emptyShares.Flatten())
+	})
 }
 
 // BenchmarkIPLDGetterOverBusyCache benchmarks the performance of the IPLDGetter when the
@@ -319,6 +493,50 @@ func randomEDS(t *testing.T) (*rsmt2d.ExtendedDataSquare, *header.ExtendedHeader
 	return eds, eh
 }
 
+// randomNamespaceRange picks a namespace range of the original shares of the EDS that spans two
+// rows partially, and returns the shares within it.
+func randomNamespaceRange(
+	t *testing.T,
+	eds *rsmt2d.ExtendedDataSquare,
+) (share.Namespace, share.Namespace, []share.Share) {
+	shares := odsShares(eds)
+	n := len(shares)
+	require.GreaterOrEqual(t, n, 16)
+	min, max := share.GetNamespace(shares[n/4+1]), share.GetNamespace(shares[3*n/4-2])
+
+	var inRange []share.Share
+	for _, shr := range shares {
+		namespace := share.GetNamespace(shr)
+		if !namespace.IsLess(min) && !max.IsLess(namespace) {
+			inRange = append(inRange, shr)
+		}
+	}
+	return min, max, inRange
+}
+
+// absentNamespaceRange returns a namespace range between the namespaces of two neighbouring
+// original shares of the EDS, so that no share is within it.
+func absentNamespaceRange(t *testing.T, eds *rsmt2d.ExtendedDataSquare) (share.Namespace, share.Namespace) {
+	shares := odsShares(eds)
+	idx := len(shares) / 2
+	min, err := addToNamespace(share.GetNamespace(shares[idx]), 1)
+	require.NoError(t, err)
+	max, err := addToNamespace(share.GetNamespace(shares[idx+1]), -1)
+	require.NoError(t, err)
+	require.False(t, max.IsLess(min))
+	return min, max
+}
+
+// odsShares returns the original shares of the EDS.
+func odsShares(eds *rsmt2d.ExtendedDataSquare) []share.Share {
+	odsWidth := eds.Width() / 2
+	shares := make([]share.Share, 0, odsWidth*odsWidth)
+	for i := uint(0); i < odsWidth; i++ {
+		shares = append(shares, eds.Row(i)[:odsWidth]...)
+	}
+	return shares
+}
+
 // randomEDSWithDoubledNamespace generates a random EDS and ensures that there are two shares in the
 // middle that share a namespace.
 func randomEDSWithDoubledNamespace(
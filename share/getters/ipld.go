@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/ipfs/boxo/blockservice"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/celestiaorg/rsmt2d"
 
@@ -73,6 +75,58 @@ func (ig *IPLDGetter) GetShare(ctx context.Context, header *header.ExtendedHeade
 	return s, nil
 }
 
+// GetSamples gets the shares at the coordinates of the given samples from the bitswap network. The
+// shares are requested concurrently.
+func (ig *IPLDGetter) GetSamples(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	samples []share.Sample,
+) (shares []share.Share, err error) {
+	ctx, span := tracer.Start(ctx, "ipld/get-samples", trace.WithAttributes(
+		attribute.Int("amount", len(samples)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	dah := header.DAH
+	if err = share.ValidateSamples(samples, len(dah.RowRoots)); err != nil {
+		return nil, err
+	}
+
+	// wrap the blockservice in a session if it has been signaled in the context.
+	blockGetter := getGetter(ctx, ig.bServ)
+	shares = make([]share.Share, len(samples))
+	errGroup, ctx := errgroup.WithContext(ctx)
+	for i, s := range samples {
+		i, s := i, s
+		errGroup.Go(func() error {
+			start := time.Now()
+			root, leaf := ipld.Translate(dah, s.Row, s.Col)
+			shr, err := ipld.GetShare(ctx, blockGetter, root, leaf, len(dah.RowRoots))
+			observeSamples(ctx, SampleAttempt{
+				Samples: []share.Sample{s},
+				Source:  "bitswap",
+				Latency: time.Since(start),
+				Err:     err,
+			})
+			if errors.Is(err, ipld.ErrNodeNotFound) {
+				// convert error to satisfy getter interface contract
+				err = share.ErrNotFound
+			}
+			if err != nil {
+				return fmt.Errorf("getter/ipld: failed to retrieve share: %w", err)
+			}
+			shares[i] = shr
+			return nil
+		})
+	}
+	if err = errGroup.Wait(); err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
+
 func (ig *IPLDGetter) GetEDS(
 	ctx context.Context,
 	header *header.ExtendedHeader,
@@ -127,6 +181,69 @@ func (ig *IPLDGetter) GetSharesByNamespace(
 	return shares, nil
 }
 
+func (ig *IPLDGetter) GetSharesByNamespaceRange(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	min, max share.Namespace,
+) (shares share.NamespacedShares, err error) {
+	ctx, span := tracer.Start(ctx, "ipld/get-shares-by-namespace-range", trace.WithAttributes(
+		attribute.String("min_namespace", min.String()),
+		attribute.String("max_namespace", max.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	if err = share.ValidateNamespaceRange(min, max); err != nil {
+		return nil, err
+	}
+
+	// wrap the blockservice in a session if it has been signaled in the context.
+	blockGetter := getGetter(ctx, ig.bServ)
+	shares, err = eds.CollectSharesByNamespaceRange(ctx, blockGetter, header.DAH, min, max)
+	if errors.Is(err, ipld.ErrNodeNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: failed to retrieve shares by namespace range: %w", err)
+	}
+	return shares, nil
+}
+
+// GetRow gets the first half of the row or column from the bitswap network.
+func (ig *IPLDGetter) GetRow(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	idx int,
+	axis rsmt2d.Axis,
+) (half *share.AxisHalf, err error) {
+	ctx, span := tracer.Start(ctx, "ipld/get-row", trace.WithAttributes(
+		attribute.Int("idx", idx),
+		attribute.String("axis", axis.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	roots := share.AxisRoots(header.DAH, axis)
+	if idx < 0 || idx >= len(roots) {
+		return nil, share.ErrOutOfBounds
+	}
+
+	// wrap the blockservice in a session if it has been signaled in the context.
+	blockGetter := getGetter(ctx, ig.bServ)
+	half, err = ipld.GetAxisHalf(ctx, blockGetter, ipld.MustCidFromNamespacedSha256(roots[idx]), len(roots))
+	if errors.Is(err, ipld.ErrNodeNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: failed to retrieve %s %d: %w", axis, idx, err)
+	}
+	return half, nil
+}
+
 var sessionKey = &session{}
 
 // session is a struct that can optionally be passed by context to the share.Getter methods using
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -16,11 +17,13 @@ import (
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/ipld"
 	"github.com/celestiaorg/celestia-node/share/p2p"
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 )
 
 var _ share.Getter = (*ShrexGetter)(nil)
@@ -34,63 +37,58 @@ const (
 
 var meter = otel.Meter("shrex/getter")
 
-type metrics struct {
-	edsAttempts metric.Int64Histogram
-	ndAttempts  metric.Int64Histogram
-}
+// requestKind is the kind of data requested by the ShrexGetter, each with its own attempts metric.
+type requestKind string
 
-func (m *metrics) recordEDSAttempt(ctx context.Context, attemptCount int, success bool) {
-	if m == nil {
-		return
-	}
-	if ctx.Err() != nil {
-		ctx = context.Background()
-	}
-	m.edsAttempts.Record(ctx, int64(attemptCount),
-		metric.WithAttributes(
-			attribute.Bool("success", success)))
+const (
+	edsRequest     requestKind = "eds"
+	ndRequest      requestKind = "nd"
+	sampleRequest  requestKind = "sample"
+	samplesRequest requestKind = "samples"
+	rowRequest     requestKind = "row"
+)
+
+var requestKinds = []requestKind{edsRequest, ndRequest, sampleRequest, samplesRequest, rowRequest}
+
+type metrics struct {
+	attempts map[requestKind]metric.Int64Histogram
 }
 
-func (m *metrics) recordNDAttempt(ctx context.Context, attemptCount int, success bool) {
+func (m *metrics) recordAttempt(ctx context.Context, kind requestKind, attemptCount int, success bool) {
 	if m == nil {
 		return
 	}
 	if ctx.Err() != nil {
 		ctx = context.Background()
 	}
-	m.ndAttempts.Record(ctx, int64(attemptCount),
+	m.attempts[kind].Record(ctx, int64(attemptCount),
 		metric.WithAttributes(
 			attribute.Bool("success", success)))
 }
 
 func (sg *ShrexGetter) WithMetrics() error {
-	edsAttemptHistogram, err := meter.Int64Histogram(
-		"getters_shrex_eds_attempts_per_request",
-		metric.WithDescription("Number of attempts per shrex/eds request"),
-	)
-	if err != nil {
-		return err
-	}
-
-	ndAttemptHistogram, err := meter.Int64Histogram(
-		"getters_shrex_nd_attempts_per_request",
-		metric.WithDescription("Number of attempts per shrex/nd request"),
-	)
-	if err != nil {
-		return err
+	attempts := make(map[requestKind]metric.Int64Histogram, len(requestKinds))
+	for _, kind := range requestKinds {
+		histogram, err := meter.Int64Histogram(
+			fmt.Sprintf("getters_shrex_%s_attempts_per_request", kind),
+			metric.WithDescription(fmt.Sprintf("Number of attempts per shrex/%s request", kind)),
+		)
+		if err != nil {
+			return err
+		}
+		attempts[kind] = histogram
 	}
 
-	sg.metrics = &metrics{
-		edsAttempts: edsAttemptHistogram,
-		ndAttempts:  ndAttemptHistogram,
-	}
+	sg.metrics = &metrics{attempts: attempts}
 	return nil
 }
 
-// ShrexGetter is a share.Getter that uses the shrex/eds and shrex/nd protocol to retrieve shares.
+// ShrexGetter is a share.Getter that uses the shrex/eds, shrex/nd, shrex/sample, shrex/samples and
+// shrex/row protocols to retrieve shares.
 type ShrexGetter struct {
-	edsClient *shrexeds.Client
-	ndClient  *shrexnd.Client
+	edsClient    *shrexeds.Client
+	ndClient     *shrexnd.Client
+	sampleClient *shrexsample.Client
 
 	peerManager *peers.Manager
 
@@ -103,10 +101,16 @@ type ShrexGetter struct {
 	metrics *metrics
 }
 
-func NewShrexGetter(edsClient *shrexeds.Client, ndClient *shrexnd.Client, peerManager *peers.Manager) *ShrexGetter {
+func NewShrexGetter(
+	edsClient *shrexeds.Client,
+	ndClient *shrexnd.Client,
+	sampleClient *shrexsample.Client,
+	peerManager *peers.Manager,
+) *ShrexGetter {
 	return &ShrexGetter{
 		edsClient:         edsClient,
 		ndClient:          ndClient,
+		sampleClient:      sampleClient,
 		peerManager:       peerManager,
 		minRequestTimeout: defaultMinRequestTimeout,
 		minAttemptsCount:  defaultMinAttemptsCount,
@@ -121,73 +125,184 @@ func (sg *ShrexGetter) Stop(ctx context.Context) error {
 	return sg.peerManager.Stop(ctx)
 }
 
-func (sg *ShrexGetter) GetShare(context.Context, *header.ExtendedHeader, int, int) (share.Share, error) {
-	return nil, fmt.Errorf("getter/shrex: GetShare %w", errOperationNotSupported)
-}
+// GetShare requests the share from the peers serving the given root with the shrex/sample protocol
+// and verifies its inclusion proof against the root of its row. Unlike the shares retrieved over
+// bitswap, the received shares are not stored locally.
+func (sg *ShrexGetter) GetShare(ctx context.Context, header *header.ExtendedHeader, row, col int) (share.Share, error) {
+	dah := header.DAH
+	upperBound := len(dah.RowRoots)
+	if row >= upperBound || col >= upperBound {
+		return nil, share.ErrOutOfBounds
+	}
 
-func (sg *ShrexGetter) GetEDS(ctx context.Context, header *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error) {
-	var (
-		attempt int
-		err     error
-	)
-	ctx, span := tracer.Start(ctx, "shrex/get-eds")
+	var err error
+	ctx, span := tracer.Start(ctx, "shrex/get-share", trace.WithAttributes(
+		attribute.Int("row", row),
+		attribute.Int("col", col),
+	))
 	defer func() {
 		utils.SetStatusAndEnd(span, err)
 	}()
 
+	// short circuit if the data root is empty
+	if dah.Equals(share.EmptyRoot()) {
+		return share.EmptyExtendedDataSquare().GetCell(uint(row), uint(col)), nil
+	}
+
+	sample, err := retrieve(ctx, sg, dah, retrieval[*shrexsample.Sample]{
+		kind: sampleRequest,
+		request: func(ctx context.Context, peer peer.ID) (*shrexsample.Sample, error) {
+			return sg.sampleClient.RequestSample(ctx, dah, row, col, peer)
+		},
+		verify: func(sample *shrexsample.Sample) error {
+			return sample.Verify(dah, row, col)
+		},
+	}, "row", row, "col", col)
+	if err != nil {
+		return nil, err
+	}
+	return sample.Share, nil
+}
+
+// GetSamples requests the shares at the coordinates of the given samples from the peers serving
+// the given root with the shrex/samples protocol, in batches of at most
+// shrexsample.MaxSamplesPerRequest samples, and verifies their inclusion proofs against the roots
+// of their rows. Like with GetShare, the received shares are not stored locally.
+func (sg *ShrexGetter) GetSamples(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	samples []share.Sample,
+) ([]share.Share, error) {
 	dah := header.DAH
+	if err := share.ValidateSamples(samples, len(dah.RowRoots)); err != nil {
+		return nil, err
+	}
+
+	var err error
+	ctx, span := tracer.Start(ctx, "shrex/get-samples", trace.WithAttributes(
+		attribute.Int("amount", len(samples)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	shares := make([]share.Share, 0, len(samples))
 	// short circuit if the data root is empty
 	if dah.Equals(share.EmptyRoot()) {
-		return share.EmptyExtendedDataSquare(), nil
+		empty := share.EmptyExtendedDataSquare()
+		for _, s := range samples {
+			shares = append(shares, empty.GetCell(uint(s.Row), uint(s.Col)))
+		}
+		return shares, nil
 	}
-	for {
-		if ctx.Err() != nil {
-			sg.metrics.recordEDSAttempt(ctx, attempt, false)
-			return nil, errors.Join(err, ctx.Err())
+	for len(samples) > 0 {
+		batch := samples[:min(len(samples), shrexsample.MaxSamplesPerRequest)]
+		samples = samples[len(batch):]
+
+		var proved []*shrexsample.Sample
+		proved, err = sg.getSamples(ctx, dah, batch)
+		if err != nil {
+			return nil, err
 		}
-		attempt++
-		start := time.Now()
-		peer, setStatus, getErr := sg.peerManager.Peer(ctx, dah.Hash())
-		if getErr != nil {
-			log.Debugw("eds: couldn't find peer",
-				"hash", dah.String(),
-				"err", getErr,
-				"finished (s)", time.Since(start))
-			sg.metrics.recordEDSAttempt(ctx, attempt, false)
-			return nil, errors.Join(err, getErr)
+		for _, s := range proved {
+			shares = append(shares, s.Share)
 		}
+	}
+	return shares, nil
+}
 
-		reqStart := time.Now()
-		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
-		eds, getErr := sg.edsClient.RequestEDS(reqCtx, dah.Hash(), peer)
-		cancel()
-		switch {
-		case getErr == nil:
-			setStatus(peers.ResultSynced)
-			sg.metrics.recordEDSAttempt(ctx, attempt, true)
-			return eds, nil
-		case errors.Is(getErr, context.DeadlineExceeded),
-			errors.Is(getErr, context.Canceled):
-			setStatus(peers.ResultCooldownPeer)
-		case errors.Is(getErr, p2p.ErrNotFound):
-			getErr = share.ErrNotFound
-			setStatus(peers.ResultCooldownPeer)
-		case errors.Is(getErr, p2p.ErrInvalidResponse):
-			setStatus(peers.ResultBlacklistPeer)
-		default:
-			setStatus(peers.ResultCooldownPeer)
-		}
+// getSamples requests a single batch of samples from the peers serving the given root, until one
+// of them responds with the shares passing the verification.
+func (sg *ShrexGetter) getSamples(
+	ctx context.Context,
+	dah *share.Root,
+	samples []share.Sample,
+) ([]*shrexsample.Sample, error) {
+	return retrieve(ctx, sg, dah, retrieval[[]*shrexsample.Sample]{
+		kind: samplesRequest,
+		request: func(ctx context.Context, peer peer.ID) ([]*shrexsample.Sample, error) {
+			return sg.sampleClient.RequestSamples(ctx, dah, samples, peer)
+		},
+		// the proofs of all the shares need verification
+		verify: func(proved []*shrexsample.Sample) error {
+			for i, s := range proved {
+				if err := s.Verify(dah, samples[i].Row, samples[i].Col); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		observe: func(peer peer.ID, latency time.Duration, err error) {
+			observeSamples(ctx, SampleAttempt{
+				Samples: samples,
+				Source:  "shrex",
+				Peer:    peer,
+				Latency: latency,
+				Err:     err,
+			})
+		},
+	}, "amount", len(samples))
+}
 
-		if !ErrorContains(err, getErr) {
-			err = errors.Join(err, getErr)
-		}
-		log.Debugw("eds: request failed",
-			"hash", dah.String(),
-			"peer", peer.String(),
-			"attempt", attempt,
-			"err", getErr,
-			"finished (s)", time.Since(reqStart))
+// GetRow requests the first half of the row or column from the peers serving the given root with
+// the shrex/row protocol and verifies its inclusion proof against the root of the axis.
+func (sg *ShrexGetter) GetRow(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisHalf, error) {
+	dah := header.DAH
+	if idx < 0 || idx >= len(dah.RowRoots) {
+		return nil, share.ErrOutOfBounds
+	}
+
+	var err error
+	ctx, span := tracer.Start(ctx, "shrex/get-row", trace.WithAttributes(
+		attribute.Int("index", idx),
+		attribute.String("axis", axis.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	// short circuit if the data root is empty
+	if dah.Equals(share.EmptyRoot()) {
+		return eds.ProveAxisHalf(share.EmptyExtendedDataSquare(), idx, axis)
 	}
+
+	half, err := retrieve(ctx, sg, dah, retrieval[*share.AxisHalf]{
+		kind: rowRequest,
+		request: func(ctx context.Context, peer peer.ID) (*share.AxisHalf, error) {
+			return sg.sampleClient.RequestRow(ctx, dah, idx, axis, peer)
+		},
+		verify: func(half *share.AxisHalf) error {
+			return half.Verify(dah, idx, axis)
+		},
+	}, "index", idx, "axis", axis.String())
+	return half, err
+}
+
+func (sg *ShrexGetter) GetEDS(ctx context.Context, header *header.ExtendedHeader) (*rsmt2d.ExtendedDataSquare, error) {
+	var err error
+	ctx, span := tracer.Start(ctx, "shrex/get-eds")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	dah := header.DAH
+	// short circuit if the data root is empty
+	if dah.Equals(share.EmptyRoot()) {
+		return share.EmptyExtendedDataSquare(), nil
+	}
+
+	eds, err := retrieve(ctx, sg, dah, retrieval[*rsmt2d.ExtendedDataSquare]{
+		kind: edsRequest,
+		request: func(ctx context.Context, peer peer.ID) (*rsmt2d.ExtendedDataSquare, error) {
+			return sg.edsClient.RequestEDS(ctx, dah.Hash(), peer)
+		},
+	})
+	return eds, err
 }
 
 func (sg *ShrexGetter) GetSharesByNamespace(
@@ -198,10 +313,7 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 	if err := namespace.ValidateForData(); err != nil {
 		return nil, err
 	}
-	var (
-		attempt int
-		err     error
-	)
+	var err error
 	ctx, span := tracer.Start(ctx, "shrex/get-shares-by-namespace", trace.WithAttributes(
 		attribute.String("namespace", namespace.String()),
 	))
@@ -216,39 +328,122 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 		return nil, nil
 	}
 
+	nd, err := retrieve(ctx, sg, dah, retrieval[share.NamespacedShares]{
+		kind: ndRequest,
+		request: func(ctx context.Context, peer peer.ID) (share.NamespacedShares, error) {
+			return sg.ndClient.RequestND(ctx, dah, namespace, peer)
+		},
+		// both inclusion and non-inclusion cases needs verification
+		verify: func(nd share.NamespacedShares) error {
+			return nd.Verify(dah, namespace)
+		},
+	}, "namespace", namespace.String())
+	return nd, err
+}
+
+func (sg *ShrexGetter) GetSharesByNamespaceRange(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	min, max share.Namespace,
+) (share.NamespacedShares, error) {
+	if err := share.ValidateNamespaceRange(min, max); err != nil {
+		return nil, err
+	}
+	var err error
+	ctx, span := tracer.Start(ctx, "shrex/get-shares-by-namespace-range", trace.WithAttributes(
+		attribute.String("min_namespace", min.String()),
+		attribute.String("max_namespace", max.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	// verify that the namespace range could exist inside the roots before starting network requests
+	dah := header.DAH
+	roots := ipld.FilterRootByNamespaceRange(dah, min, max)
+	if len(roots) == 0 {
+		return nil, nil
+	}
+
+	nd, err := retrieve(ctx, sg, dah, retrieval[share.NamespacedShares]{
+		kind: ndRequest,
+		request: func(ctx context.Context, peer peer.ID) (share.NamespacedShares, error) {
+			return sg.ndClient.RequestNDRange(ctx, dah, min, max, peer)
+		},
+		verify: func(nd share.NamespacedShares) error {
+			return nd.VerifyRange(dah, min, max)
+		},
+	}, "min_namespace", min.String(), "max_namespace", max.String())
+	return nd, err
+}
+
+// retrieval describes a single kind of request of the ShrexGetter.
+type retrieval[T any] struct {
+	kind requestKind
+	// request requests the data from the given peer.
+	request func(context.Context, peer.ID) (T, error)
+	// verify checks the received data, if set. The peer is blacklisted if the check fails.
+	verify func(T) error
+	// observe reports the outcome of every request, if set.
+	observe func(peer peer.ID, latency time.Duration, err error)
+}
+
+// retrieve requests the data from the peers serving the given root, one peer per attempt, until
+// one of them responds with the data passing the verification. The time left is split between the
+// attempts, so that multiple peers are attempted before the context is done.
+func retrieve[T any](
+	ctx context.Context,
+	sg *ShrexGetter,
+	dah *share.Root,
+	r retrieval[T],
+	logKeysAndValues ...any,
+) (T, error) {
+	logger := log.With(append([]any{"hash", dah.String()}, logKeysAndValues...)...)
+	var (
+		attempt int
+		err     error
+		empty   T
+	)
 	for {
 		if ctx.Err() != nil {
-			sg.metrics.recordNDAttempt(ctx, attempt, false)
-			return nil, errors.Join(err, ctx.Err())
+			sg.metrics.recordAttempt(ctx, r.kind, attempt, false)
+			return empty, errors.Join(err, ctx.Err())
 		}
 		attempt++
 		start := time.Now()
 		peer, setStatus, getErr := sg.peerManager.Peer(ctx, dah.Hash())
 		if getErr != nil {
-			log.Debugw("nd: couldn't find peer",
-				"hash", dah.String(),
-				"namespace", namespace.String(),
+			logger.Debugw(string(r.kind)+": couldn't find peer",
 				"err", getErr,
 				"finished (s)", time.Since(start))
-			sg.metrics.recordNDAttempt(ctx, attempt, false)
-			return nil, errors.Join(err, getErr)
+			sg.metrics.recordAttempt(ctx, r.kind, attempt, false)
+			return empty, errors.Join(err, getErr)
 		}
 
 		reqStart := time.Now()
 		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
-		nd, getErr := sg.ndClient.RequestND(reqCtx, dah, namespace, peer)
+		data, getErr := r.request(reqCtx, peer)
 		cancel()
+		var verErr error
+		if getErr == nil && r.verify != nil {
+			verErr = r.verify(data)
+		}
+		if r.observe != nil {
+			r.observe(peer, time.Since(reqStart), errors.Join(getErr, verErr))
+		}
 		switch {
+		case verErr != nil:
+			getErr = verErr
+			setStatus(peers.ResultBlacklistPeer)
 		case getErr == nil:
-			// both inclusion and non-inclusion cases needs verification
-			if verErr := nd.Verify(dah, namespace); verErr != nil {
-				getErr = verErr
-				setStatus(peers.ResultBlacklistPeer)
-				break
+			if r.kind == edsRequest {
+				// the peer has the whole square, so the pool of the root is synced
+				setStatus(peers.ResultSynced)
+			} else {
+				setStatus(peers.ResultNoop)
 			}
-			setStatus(peers.ResultNoop)
-			sg.metrics.recordNDAttempt(ctx, attempt, true)
-			return nd, nil
+			sg.metrics.recordAttempt(ctx, r.kind, attempt, true)
+			return data, nil
 		case errors.Is(getErr, context.DeadlineExceeded),
 			errors.Is(getErr, context.Canceled):
 			setStatus(peers.ResultCooldownPeer)
@@ -264,9 +459,7 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 		if !ErrorContains(err, getErr) {
 			err = errors.Join(err, getErr)
 		}
-		log.Debugw("nd: request failed",
-			"hash", dah.String(),
-			"namespace", namespace.String(),
+		logger.Debugw(string(r.kind)+": request failed",
 			"peer", peer.String(),
 			"attempt", attempt,
 			"err", getErr,
@@ -27,6 +27,7 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 	"github.com/celestiaorg/celestia-node/share/sharetest"
 )
@@ -48,12 +49,13 @@ func TestShrexGetter(t *testing.T) {
 
 	ndClient, _ := newNDClientServer(ctx, t, edsStore, srvHost, clHost)
 	edsClient, _ := newEDSClientServer(ctx, t, edsStore, srvHost, clHost)
+	sampleClient, _ := newSampleClientServer(ctx, t, edsStore, srvHost, clHost)
 
 	// create shrex Getter
 	sub := new(headertest.Subscriber)
 	peerManager, err := testManager(ctx, clHost, sub)
 	require.NoError(t, err)
-	getter := NewShrexGetter(edsClient, ndClient, peerManager)
+	getter := NewShrexGetter(edsClient, ndClient, sampleClient, peerManager)
 	require.NoError(t, getter.Start(ctx))
 
 	t.Run("ND_Available, total data size > 1mb", func(t *testing.T) {
@@ -141,6 +143,50 @@ func TestShrexGetter(t *testing.T) {
 		require.Nil(t, emptyShares.Verify(dah, namespace))
 	})
 
+	t.Run("ND_range_available", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		eds, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		min, max, expected := randomNamespaceRange(t, eds)
+		got, err := getter.GetSharesByNamespaceRange(ctx, eh, min, max)
+		require.NoError(t, err)
+		require.NoError(t, got.VerifyRange(dah, min, max))
+		require.Equal(t, expected, got.Flatten())
+	})
+
+	t.Run("ND_range_not_included", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		eds, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		min, max := absentNamespaceRange(t, eds)
+		// check for namespace range to be between max and min namespace in root
+		require.Len(t, ipld.FilterRootByNamespaceRange(dah, min, max), 1)
+
+		emptyShares, err := getter.GetSharesByNamespaceRange(ctx, eh, min, max)
+		require.NoError(t, err)
+		// no shares should be returned
+		require.Empty(t, emptyShares.Flatten())
+		require.Nil(t, emptyShares.VerifyRange(dah, min, max))
+	})
+
 	t.Run("EDS_Available", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 		t.Cleanup(cancel)
@@ -190,6 +236,139 @@ func TestShrexGetter(t *testing.T) {
 		_, err := getter.GetEDS(ctx, eh)
 		require.ErrorIs(t, err, share.ErrNotFound)
 	})
+
+	t.Run("Sample_Available", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		randEDS, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), randEDS))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		// sample a share of every quadrant
+		width := int(randEDS.Width())
+		for _, coords := range [][2]int{{0, 0}, {0, width - 1}, {width - 1, 0}, {width - 1, width - 1}} {
+			got, err := getter.GetShare(ctx, eh, coords[0], coords[1])
+			require.NoError(t, err)
+			require.Equal(t, randEDS.GetCell(uint(coords[0]), uint(coords[1])), got)
+		}
+
+		_, err := getter.GetShare(ctx, eh, width, 0)
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+	})
+
+	t.Run("Sample_err_not_found", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		_, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		_, err := getter.GetShare(ctx, eh, 0, 0)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("Samples_Available", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		randEDS, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), randEDS))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		// request more samples than fit into a single batch
+		width := int(randEDS.Width())
+		samples := make([]share.Sample, 0, shrexsample.MaxSamplesPerRequest+1)
+		for len(samples) < cap(samples) {
+			samples = append(samples, share.Sample{Row: len(samples) % width, Col: len(samples) / width % width})
+		}
+		shares, err := getter.GetSamples(ctx, eh, samples)
+		require.NoError(t, err)
+		require.Len(t, shares, len(samples))
+		for i, s := range samples {
+			require.Equal(t, randEDS.GetCell(uint(s.Row), uint(s.Col)), shares[i])
+		}
+
+		_, err = getter.GetSamples(ctx, eh, []share.Sample{{Row: 0, Col: width}})
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+	})
+
+	t.Run("Samples_err_not_found", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		_, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		_, err := getter.GetSamples(ctx, eh, []share.Sample{{Row: 0, Col: 0}})
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("Row_Available", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		randEDS, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), randEDS))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		width := int(randEDS.Width())
+		half, err := getter.GetRow(ctx, eh, width-1, rsmt2d.Row)
+		require.NoError(t, err)
+		shares, err := half.Extend()
+		require.NoError(t, err)
+		require.Equal(t, randEDS.Row(uint(width-1)), shares)
+
+		half, err = getter.GetRow(ctx, eh, 0, rsmt2d.Col)
+		require.NoError(t, err)
+		shares, err = half.Extend()
+		require.NoError(t, err)
+		require.Equal(t, randEDS.Col(0), shares)
+
+		_, err = getter.GetRow(ctx, eh, width, rsmt2d.Row)
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+	})
+
+	t.Run("Row_err_not_found", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		_, dah, _ := generateTestEDS(t)
+		eh := headertest.RandExtendedHeaderWithRoot(t, dah)
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		_, err := getter.GetRow(ctx, eh, 0, rsmt2d.Row)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
 }
 
 func newStore(t *testing.T) (*eds.Store, error) {
@@ -234,7 +413,7 @@ func newNDClientServer(
 	params := shrexnd.DefaultParameters()
 
 	// create server and register handler
-	server, err := shrexnd.NewServer(params, srvHost, edsStore)
+	server, err := shrexnd.NewServer(params, srvHost, edsStore, nil)
 	require.NoError(t, err)
 	require.NoError(t, server.Start(ctx))
 
@@ -268,6 +447,26 @@ func newEDSClientServer(
 	return client, server
 }
 
+func newSampleClientServer(
+	ctx context.Context, t *testing.T, edsStore *eds.Store, srvHost, clHost host.Host,
+) (*shrexsample.Client, *shrexsample.Server) {
+	params := shrexsample.DefaultParameters()
+
+	// create server and register handler
+	server, err := shrexsample.NewServer(params, srvHost, edsStore)
+	require.NoError(t, err)
+	require.NoError(t, server.Start(ctx))
+
+	t.Cleanup(func() {
+		_ = server.Stop(ctx)
+	})
+
+	// create client and connect it to server
+	client, err := shrexsample.NewClient(params, clHost)
+	require.NoError(t, err)
+	return client, server
+}
+
 // addToNamespace adds arbitrary int value to namespace, treating namespace as big-endian
 // implementation of int
 func addToNamespace(namespace share.Namespace, val int) (share.Namespace, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -80,6 +81,65 @@ func (sg *StoreGetter) GetShare(ctx context.Context, header *header.ExtendedHead
 	return s, nil
 }
 
+// GetSamples gets the shares at the coordinates of the given samples from the eds.Store through
+// the corresponding CAR-level blockstore, which is opened once for all the samples.
+func (sg *StoreGetter) GetSamples(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	samples []share.Sample,
+) (shares []share.Share, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-samples", trace.WithAttributes(
+		attribute.Int("amount", len(samples)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	dah := header.DAH
+	if err = share.ValidateSamples(samples, len(dah.RowRoots)); err != nil {
+		return nil, err
+	}
+	bs, err := sg.store.CARBlockstore(ctx, dah.Hash())
+	if errors.Is(err, eds.ErrNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve blockstore: %w", err)
+	}
+	defer func() {
+		if err := bs.Close(); err != nil {
+			log.Warnw("closing blockstore", "err", err)
+		}
+	}()
+
+	start := time.Now()
+	defer func() {
+		observeSamples(ctx, SampleAttempt{
+			Samples: samples,
+			Source:  "store",
+			Latency: time.Since(start),
+			Err:     err,
+		})
+	}()
+
+	// wrap the read-only CAR blockstore in a getter
+	blockGetter := eds.NewBlockGetter(bs)
+	shares = make([]share.Share, len(samples))
+	for i, s := range samples {
+		root, leaf := ipld.Translate(dah, s.Row, s.Col)
+		shares[i], err = ipld.GetShare(ctx, blockGetter, root, leaf, len(dah.RowRoots))
+		if errors.Is(err, ipld.ErrNodeNotFound) {
+			// convert error to satisfy getter interface contract
+			err = share.ErrNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getter/store: failed to retrieve share: %w", err)
+		}
+	}
+	return shares, nil
+}
+
 // GetEDS gets the EDS identified by the given root from the EDS store.
 func (sg *StoreGetter) GetEDS(
 	ctx context.Context, header *header.ExtendedHeader,
@@ -100,6 +160,29 @@ func (sg *StoreGetter) GetEDS(
 	return data, nil
 }
 
+// GetRow gets the first half of the row or column from the EDS store through the corresponding
+// CAR-level blockstore.
+func (sg *StoreGetter) GetRow(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	idx int,
+	axis rsmt2d.Axis,
+) (half *share.AxisHalf, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-row", trace.WithAttributes(
+		attribute.Int("idx", idx),
+		attribute.String("axis", axis.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	half, err = eds.RetrieveAxisHalfFromStore(ctx, sg.store, header.DAH, idx, axis)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: %w", err)
+	}
+	return half, nil
+}
+
 // GetSharesByNamespace gets all EDS shares in the given namespace from the EDS store through the
 // corresponding CAR-level blockstore.
 func (sg *StoreGetter) GetSharesByNamespace(
@@ -120,3 +203,25 @@ func (sg *StoreGetter) GetSharesByNamespace(
 	}
 	return ns, nil
 }
+
+// GetSharesByNamespaceRange gets all EDS shares within the given namespace range from the EDS store
+// through the corresponding CAR-level blockstore.
+func (sg *StoreGetter) GetSharesByNamespaceRange(
+	ctx context.Context,
+	header *header.ExtendedHeader,
+	min, max share.Namespace,
+) (shares share.NamespacedShares, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-shares-by-namespace-range", trace.WithAttributes(
+		attribute.String("min_namespace", min.String()),
+		attribute.String("max_namespace", max.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	ns, err := eds.RetrieveNamespaceRangeFromStore(ctx, sg.store, header.DAH, min, max)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: %w", err)
+	}
+	return ns, nil
+}
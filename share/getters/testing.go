@@ -13,6 +13,7 @@ import (
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 )
 
@@ -28,7 +29,8 @@ func TestGetter(t *testing.T) (share.Getter, *header.ExtendedHeader) {
 }
 
 // SingleEDSGetter contains a single EDS where data is retrieved from.
-// Its primary use is testing, and GetSharesByNamespace is not supported.
+// Its primary use is testing, and GetSharesByNamespace and GetSharesByNamespaceRange are not
+// supported.
 type SingleEDSGetter struct {
 	EDS *rsmt2d.ExtendedDataSquare
 }
@@ -46,6 +48,26 @@ func (seg *SingleEDSGetter) GetShare(
 	return seg.EDS.GetCell(uint(row), uint(col)), nil
 }
 
+// GetSamples gets the shares of the samples from a kept EDS if the correct root is given.
+func (seg *SingleEDSGetter) GetSamples(
+	_ context.Context,
+	header *header.ExtendedHeader,
+	samples []share.Sample,
+) ([]share.Share, error) {
+	err := seg.checkRoot(header.DAH)
+	if err != nil {
+		return nil, err
+	}
+	if err := share.ValidateSamples(samples, int(seg.EDS.Width())); err != nil {
+		return nil, err
+	}
+	shares := make([]share.Share, len(samples))
+	for i, s := range samples {
+		shares[i] = seg.EDS.GetCell(uint(s.Row), uint(s.Col))
+	}
+	return shares, nil
+}
+
 // GetEDS returns a kept EDS if the correct root is given.
 func (seg *SingleEDSGetter) GetEDS(
 	_ context.Context,
@@ -64,6 +86,28 @@ func (seg *SingleEDSGetter) GetSharesByNamespace(context.Context, *header.Extend
 	panic("SingleEDSGetter: GetSharesByNamespace is not implemented")
 }
 
+// GetSharesByNamespaceRange returns NamespacedShares of the namespace range from a kept EDS if the
+// correct root is given.
+func (seg *SingleEDSGetter) GetSharesByNamespaceRange(context.Context, *header.ExtendedHeader, share.Namespace,
+	share.Namespace,
+) (share.NamespacedShares, error) {
+	panic("SingleEDSGetter: GetSharesByNamespaceRange is not implemented")
+}
+
+// GetRow returns the first half of the row or column of a kept EDS if the correct root is given.
+func (seg *SingleEDSGetter) GetRow(
+	_ context.Context,
+	header *header.ExtendedHeader,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisHalf, error) {
+	err := seg.checkRoot(header.DAH)
+	if err != nil {
+		return nil, err
+	}
+	return eds.ProveAxisHalf(seg.EDS, idx, axis)
+}
+
 func (seg *SingleEDSGetter) checkRoot(root *share.Root) error {
 	dah, err := da.NewDataAvailabilityHeader(seg.EDS)
 	if err != nil {
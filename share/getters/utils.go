@@ -6,7 +6,10 @@ import (
 	"time"
 
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.opentelemetry.io/otel"
+
+	"github.com/celestiaorg/celestia-node/share"
 )
 
 var (
@@ -55,3 +58,34 @@ func ErrorContains(err, target error) bool {
 	}
 	return ErrorContains(err, target)
 }
+
+// SampleAttempt describes a single attempt of a share.Getter to retrieve a set of samples.
+type SampleAttempt struct {
+	Samples []share.Sample
+	// Source is the kind of the source the samples were requested from.
+	Source string
+	// Peer is the peer the samples were requested from. It is empty if the peer is not known.
+	Peer    peer.ID
+	Latency time.Duration
+	Err     error
+}
+
+// SampleObserver is notified about every attempt to retrieve samples. It may be called
+// concurrently.
+type SampleObserver func(SampleAttempt)
+
+type sampleObserverKey struct{}
+
+// WithSampleObserver stores the SampleObserver in the context, so that the share.Getters
+// supporting it report their attempts of GetSamples to it.
+func WithSampleObserver(ctx context.Context, observer SampleObserver) context.Context {
+	return context.WithValue(ctx, sampleObserverKey{}, observer)
+}
+
+// observeSamples reports the attempt to the SampleObserver of the context, if there is one.
+func observeSamples(ctx context.Context, attempt SampleAttempt) {
+	observer, ok := ctx.Value(sampleObserverKey{}).(SampleObserver)
+	if ok {
+		observer(attempt)
+	}
+}
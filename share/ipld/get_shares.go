@@ -2,10 +2,12 @@ package ipld
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ipfs/boxo/blockservice"
 	"github.com/ipfs/go-cid"
 	format "github.com/ipfs/go-ipld-format"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/celestiaorg/nmt"
 
@@ -48,7 +50,20 @@ func GetSharesByNamespace(
 	namespace share.Namespace,
 	maxShares int,
 ) ([]share.Share, *nmt.Proof, error) {
-	data := NewNamespaceData(maxShares, namespace, WithLeaves(), WithProofs())
+	return GetSharesByNamespaceRange(ctx, bGetter, root, namespace, namespace, maxShares)
+}
+
+// GetSharesByNamespaceRange walks the tree of a given root and returns its shares within all the
+// Namespaces between min and max, inclusive. If a share could not be retrieved, err is not nil, and
+// the returned array contains nil shares in place of the shares it was unable to retrieve.
+func GetSharesByNamespaceRange(
+	ctx context.Context,
+	bGetter blockservice.BlockGetter,
+	root cid.Cid,
+	min, max share.Namespace,
+	maxShares int,
+) ([]share.Share, *nmt.Proof, error) {
+	data := NewNamespaceRangeData(maxShares, min, max, WithLeaves(), WithProofs())
 	err := data.CollectLeavesByNamespace(ctx, bGetter, root)
 	if err != nil {
 		return nil, nil, err
@@ -65,6 +80,75 @@ func GetSharesByNamespace(
 	return shares, data.Proof(), err
 }
 
+// GetAxisHalf walks the tree of the given row or column root and returns the shares of the first
+// half of the axis along with the proof of their inclusion under the root. Unlike GetShares, it
+// fails as soon as any of the nodes couldn't be retrieved.
+func GetAxisHalf(
+	ctx context.Context,
+	bGetter blockservice.BlockGetter,
+	root cid.Cid,
+	width int, // this corresponds to the extended square width
+) (*share.AxisHalf, error) {
+	nd, err := GetNode(ctx, bGetter, root)
+	if err != nil {
+		return nil, err
+	}
+	lnks := nd.Links()
+	if len(lnks) != 2 {
+		return nil, fmt.Errorf("unexpected amount of links of the axis root: %d", len(lnks))
+	}
+
+	half := width / 2
+	shares := make([]share.Share, half)
+	err = getSubtreeShares(ctx, bGetter, lnks[0].Cid, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	// the first half is the left subtree of the root, so its only proof node is the right one
+	proof := nmt.NewInclusionProof(0, half, [][]byte{NamespacedSha256FromCID(lnks[1].Cid)}, true)
+	return &share.AxisHalf{
+		Shares: shares,
+		Proof:  &proof,
+	}, nil
+}
+
+// getSubtreeShares concurrently walks the subtree of the given root and puts its leaves into the
+// given slice, which length has to match the amount of leaves of the subtree.
+func getSubtreeShares(
+	ctx context.Context,
+	bGetter blockservice.BlockGetter,
+	root cid.Cid,
+	shares []share.Share,
+) error {
+	nd, err := GetNode(ctx, bGetter, root)
+	if err != nil {
+		return err
+	}
+	lnks := nd.Links()
+	if len(lnks) == 0 {
+		if len(shares) != 1 {
+			return fmt.Errorf("unexpected leaf in place of subtree of %d leaves", len(shares))
+		}
+		shares[0] = leafToShare(nd)
+		return nil
+	}
+	if len(lnks) != 2 || len(shares) < 2 {
+		return fmt.Errorf("unexpected inner node in place of subtree of %d leaves", len(shares))
+	}
+
+	// (bin-tree-feat)
+	half := len(shares) / 2
+	errGrp, ctx := errgroup.WithContext(ctx)
+	errGrp.Go(func() error {
+		return getSubtreeShares(ctx, bGetter, lnks[0].Cid, shares[:half])
+	})
+	errGrp.Go(func() error {
+		return getSubtreeShares(ctx, bGetter, lnks[1].Cid, shares[half:])
+	})
+	return errGrp.Wait()
+}
+
 // leafToShare converts an NMT leaf into a Share.
 func leafToShare(nd format.Node) share.Share {
 	// * Additional namespace is prepended so that parity data can be identified with a parity
@@ -39,27 +39,38 @@ func WithProofs() Option {
 	}
 }
 
-// NamespaceData stores all leaves under the given namespace with their corresponding proofs.
+// NamespaceData stores all leaves under the given namespace or namespace range with their
+// corresponding proofs.
 type NamespaceData struct {
 	leaves []ipld.Node
 	proofs *proofCollector
 
 	bounds    fetchedBounds
 	maxShares int
-	namespace share.Namespace
+	// minNamespace and maxNamespace are the inclusive bounds of the target namespace range. They are
+	// equal when a single namespace is targeted.
+	minNamespace share.Namespace
+	maxNamespace share.Namespace
 
 	isAbsentNamespace atomic.Bool
 	absenceProofLeaf  ipld.Node
 }
 
 func NewNamespaceData(maxShares int, namespace share.Namespace, options ...Option) *NamespaceData {
+	return NewNamespaceRangeData(maxShares, namespace, namespace, options...)
+}
+
+// NewNamespaceRangeData creates NamespaceData that collects the leaves of all the namespaces
+// between min and max, inclusive.
+func NewNamespaceRangeData(maxShares int, min, max share.Namespace, options ...Option) *NamespaceData {
 	data := &NamespaceData{
 		// we don't know where in the tree the leaves in the namespace are,
 		// so we keep track of the bounds to return the correct slice
 		// maxShares acts as a sentinel to know if we find any leaves
-		bounds:    fetchedBounds{int64(maxShares), 0},
-		maxShares: maxShares,
-		namespace: namespace,
+		bounds:       fetchedBounds{int64(maxShares), 0},
+		maxShares:    maxShares,
+		minNamespace: min,
+		maxNamespace: max,
 	}
 
 	for _, opt := range options {
@@ -69,16 +80,22 @@ func NewNamespaceData(maxShares int, namespace share.Namespace, options ...Optio
 }
 
 func (n *NamespaceData) validate(rootCid cid.Cid) error {
-	if err := n.namespace.Validate(); err != nil {
+	if err := n.minNamespace.Validate(); err != nil {
+		return err
+	}
+	if err := n.maxNamespace.Validate(); err != nil {
 		return err
 	}
+	if n.maxNamespace.IsLess(n.minNamespace) {
+		return errors.New("share/ipld: min namespace is greater than max namespace")
+	}
 
 	if n.leaves == nil && n.proofs == nil {
 		return errors.New("share/ipld: empty NamespaceData, nothing specified to retrieve")
 	}
 
 	root := NamespacedSha256FromCID(rootCid)
-	if n.namespace.IsOutsideRange(root, root) {
+	if n.isOutsideRange(root, root) {
 		return ErrNamespaceOutsideRange
 	}
 	return nil
@@ -228,7 +245,8 @@ func (n *NamespaceData) CollectLeavesByNamespace(
 					retrievalErr = err
 				})
 				log.Errorw("could not retrieve IPLD node",
-					"namespace", n.namespace.String(),
+					"min_namespace", n.minNamespace.String(),
+					"max_namespace", n.maxNamespace.String(),
 					"pos", j.sharePos,
 					"err", err,
 				)
@@ -282,17 +300,17 @@ func (n *NamespaceData) collectNDWithProofs(j job, links []*ipld.Link) []job {
 
 	var nextJobs []job
 	// check if target namespace is outside of boundaries of both links
-	if n.namespace.IsOutsideRange(leftLink, rightLink) {
+	if n.isOutsideRange(leftLink, rightLink) {
 		log.Fatalf("target namespace outside of boundaries of links at depth: %v", j.depth)
 	}
 
-	if !n.namespace.IsAboveMax(leftLink) {
+	if !n.minNamespace.IsAboveMax(leftLink) {
 		// namespace is within the range of left link
 		nextJobs = append(nextJobs, j.next(left, leftCid, false))
 	} else {
 		// proof is on the left side, if the namespace is on the right side of the range of left link
 		n.addProof(left, leftCid, j.depth)
-		if n.namespace.IsBelowMin(rightLink) {
+		if n.maxNamespace.IsBelowMin(rightLink) {
 			// namespace is not included in either links, convert to absence collector
 			n.isAbsentNamespace.Store(true)
 			nextJobs = append(nextJobs, j.next(right, rightCid, true))
@@ -300,7 +318,7 @@ func (n *NamespaceData) collectNDWithProofs(j job, links []*ipld.Link) []job {
 		}
 	}
 
-	if !n.namespace.IsBelowMin(rightLink) {
+	if !n.maxNamespace.IsBelowMin(rightLink) {
 		// namespace is within the range of right link
 		nextJobs = append(nextJobs, j.next(right, rightCid, false))
 	} else {
@@ -310,6 +328,12 @@ func (n *NamespaceData) collectNDWithProofs(j job, links []*ipld.Link) []job {
 	return nextJobs
 }
 
+// isOutsideRange checks if the target namespace range doesn't intersect the min-max range of the
+// given hashes.
+func (n *NamespaceData) isOutsideRange(leftNodeHash, rightNodeHash []byte) bool {
+	return n.maxNamespace.IsBelowMin(leftNodeHash) || n.minNamespace.IsAboveMax(rightNodeHash)
+}
+
 type fetchedBounds struct {
 	lowest  int64
 	highest int64
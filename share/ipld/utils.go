@@ -8,9 +8,32 @@ import (
 
 // FilterRootByNamespace returns the row roots from the given share.Root that contain the namespace.
 func FilterRootByNamespace(root *share.Root, namespace share.Namespace) []cid.Cid {
+	rowIdxs := FilterRowIndexesByNamespace(root, namespace)
+	rowRootCIDs := make([]cid.Cid, 0, len(rowIdxs))
+	for _, idx := range rowIdxs {
+		rowRootCIDs = append(rowRootCIDs, MustCidFromNamespacedSha256(root.RowRoots[idx]))
+	}
+	return rowRootCIDs
+}
+
+// FilterRowIndexesByNamespace returns the indexes of the row roots from the given share.Root that
+// contain the namespace.
+func FilterRowIndexesByNamespace(root *share.Root, namespace share.Namespace) []int {
+	rowIdxs := make([]int, 0, len(root.RowRoots))
+	for i, row := range root.RowRoots {
+		if !namespace.IsOutsideRange(row, row) {
+			rowIdxs = append(rowIdxs, i)
+		}
+	}
+	return rowIdxs
+}
+
+// FilterRootByNamespaceRange returns the row roots from the given share.Root that contain any of
+// the namespaces between min and max, inclusive.
+func FilterRootByNamespaceRange(root *share.Root, min, max share.Namespace) []cid.Cid {
 	rowRootCIDs := make([]cid.Cid, 0, len(root.RowRoots))
 	for _, row := range root.RowRoots {
-		if !namespace.IsOutsideRange(row, row) {
+		if !max.IsBelowMin(row) && !min.IsAboveMax(row) {
 			rowRootCIDs = append(rowRootCIDs, MustCidFromNamespacedSha256(row))
 		}
 	}
@@ -52,6 +52,36 @@ func (mr *MockGetterMockRecorder) GetEDS(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEDS", reflect.TypeOf((*MockGetter)(nil).GetEDS), arg0, arg1)
 }
 
+// GetRow mocks base method.
+func (m *MockGetter) GetRow(arg0 context.Context, arg1 *header.ExtendedHeader, arg2 int, arg3 rsmt2d.Axis) (*share.AxisHalf, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRow", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*share.AxisHalf)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRow indicates an expected call of GetRow.
+func (mr *MockGetterMockRecorder) GetRow(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRow", reflect.TypeOf((*MockGetter)(nil).GetRow), arg0, arg1, arg2, arg3)
+}
+
+// GetSamples mocks base method.
+func (m *MockGetter) GetSamples(arg0 context.Context, arg1 *header.ExtendedHeader, arg2 []share.Sample) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSamples", arg0, arg1, arg2)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSamples indicates an expected call of GetSamples.
+func (mr *MockGetterMockRecorder) GetSamples(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSamples", reflect.TypeOf((*MockGetter)(nil).GetSamples), arg0, arg1, arg2)
+}
+
 // GetShare mocks base method.
 func (m *MockGetter) GetShare(arg0 context.Context, arg1 *header.ExtendedHeader, arg2, arg3 int) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -81,3 +111,18 @@ func (mr *MockGetterMockRecorder) GetSharesByNamespace(arg0, arg1, arg2 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespace", reflect.TypeOf((*MockGetter)(nil).GetSharesByNamespace), arg0, arg1, arg2)
 }
+
+// GetSharesByNamespaceRange mocks base method.
+func (m *MockGetter) GetSharesByNamespaceRange(arg0 context.Context, arg1 *header.ExtendedHeader, arg2, arg3 share.Namespace) (share.NamespacedShares, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharesByNamespaceRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(share.NamespacedShares)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharesByNamespaceRange indicates an expected call of GetSharesByNamespaceRange.
+func (mr *MockGetterMockRecorder) GetSharesByNamespaceRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespaceRange", reflect.TypeOf((*MockGetter)(nil).GetSharesByNamespaceRange), arg0, arg1, arg2, arg3)
+}
@@ -139,6 +139,21 @@ func (n Namespace) ValidateForBlob() error {
 	return nil
 }
 
+// ValidateNamespaceRange checks if the min and max Namespaces are of real/useful data and min is
+// not greater than max.
+func ValidateNamespaceRange(min, max Namespace) error {
+	if err := min.ValidateForData(); err != nil {
+		return err
+	}
+	if err := max.ValidateForData(); err != nil {
+		return err
+	}
+	if max.IsLess(min) {
+		return fmt.Errorf("invalid namespace range: min namespace(%s) is greater than max namespace(%s)", min, max)
+	}
+	return nil
+}
+
 // IsAboveMax checks if the namespace is above the maximum namespace of the given hash.
 func (n Namespace) IsAboveMax(nodeHash []byte) bool {
 	return !n.IsLessOrEqual(nodeHash[n.Len() : n.Len()*2])
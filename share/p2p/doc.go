@@ -10,6 +10,9 @@
 //     This protocol exchanges the original data square in between the client and server, and it's up to the
 //     receiver to compute the extended data square.
 //
+//   - shrexsample: a request/response protocol that is used to request single shares of the extended data
+//     square along with their inclusion proofs from peers. It is used by light nodes for sampling.
+//
 // This package also defines a peer manager that is used to manage network peers that can be used to exchange
 // shares. The peer manager is primarily responsible for providing peers to request shares from,
 // and is primarily used by `getters.ShrexGetter` in share/getters/shrex.go.
@@ -0,0 +1,361 @@
+package shrexnd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/zap"
+
+	libhead "github.com/celestiaorg/go-header"
+	"github.com/celestiaorg/go-libp2p-messenger/serde"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexnd/pb"
+)
+
+// BatchResult is the namespaced data of a single root of a batch request.
+type BatchResult struct {
+	// RootHash is the hash of the root the shares belong to. It is empty if the peer doesn't know
+	// the root of the requested height.
+	RootHash share.DataHash
+	// Height is the height of the root. It is set only for the requests of height ranges.
+	Height uint64
+	// Shares are the namespaced shares of the root with unverified inclusion proofs.
+	Shares share.NamespacedShares
+	// Err is p2p.ErrNotFound if the peer doesn't have the data of the root, or
+	// p2p.ErrInvalidResponse if it failed to serve it.
+	Err error
+}
+
+// RequestNDBatch requests namespaced data of the given roots from the given peer on a single
+// stream.
+// Returns a result for every root, in the order of the roots, with unverified inclusion proofs
+// against the share.Root.
+func (c *Client) RequestNDBatch(
+	ctx context.Context,
+	roots []*share.Root,
+	namespace share.Namespace,
+	peer peer.ID,
+) ([]*BatchResult, error) {
+	req := &pb.GetSharesByNamespaceBatchRequest{
+		RootHashes: make([][]byte, len(roots)),
+		Namespace:  namespace,
+	}
+	for i, root := range roots {
+		req.RootHashes[i] = root.Hash()
+	}
+	if err := validateBatchRequest(req); err != nil {
+		return nil, err
+	}
+
+	results, err := c.requestBatch(ctx, req, peer)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		if !bytes.Equal(result.RootHash, req.RootHashes[i]) {
+			return nil, fmt.Errorf("%w: unexpected root %s at position %d",
+				p2p.ErrInvalidResponse, result.RootHash.String(), i)
+		}
+	}
+	return results, nil
+}
+
+// RequestNDByHeightRange requests namespaced data of the roots of all the heights between from and
+// to, inclusive, from the given peer on a single stream. The roots are resolved by the peer and
+// checked against the headers of the local getter, so the heights must be synced locally.
+// Returns a result for every height, in ascending order, with unverified inclusion proofs against
+// the share.Root.
+func (c *Client) RequestNDByHeightRange(
+	ctx context.Context,
+	headers libhead.Getter[*header.ExtendedHeader],
+	from, to uint64,
+	namespace share.Namespace,
+	peer peer.ID,
+) ([]*BatchResult, error) {
+	req := &pb.GetSharesByNamespaceBatchRequest{
+		FromHeight: from,
+		ToHeight:   to,
+		Namespace:  namespace,
+	}
+	if err := validateBatchRequest(req); err != nil {
+		return nil, err
+	}
+
+	head, err := headers.Head(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("client-nd: getting local head: %w", err)
+	}
+	if to > head.Height() {
+		return nil, fmt.Errorf("client-nd: height %d is above the local head %d", to, head.Height())
+	}
+
+	results, err := c.requestBatch(ctx, req, peer)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		height := from + uint64(i)
+		if result.Height != height {
+			return nil, fmt.Errorf("%w: unexpected height %d at position %d",
+				p2p.ErrInvalidResponse, result.Height, i)
+		}
+		if len(result.RootHash) == 0 {
+			// the peer doesn't know the root of the height
+			continue
+		}
+
+		eh, err := headers.GetByHeight(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("client-nd: getting local header %d: %w", height, err)
+		}
+		if !bytes.Equal(result.RootHash, eh.DAH.Hash()) {
+			return nil, fmt.Errorf("%w: unexpected root %s at height %d",
+				p2p.ErrInvalidResponse, result.RootHash.String(), height)
+		}
+	}
+	return results, nil
+}
+
+func (c *Client) requestBatch(
+	ctx context.Context,
+	req *pb.GetSharesByNamespaceBatchRequest,
+	peer peer.ID,
+) ([]*BatchResult, error) {
+	results, err := c.doBatchRequest(ctx, req, peer)
+	if err != nil {
+		return nil, c.handleRequestErr(ctx, err)
+	}
+
+	if len(results) != batchSize(req) {
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
+		return nil, fmt.Errorf("%w: expected %d results, got %d",
+			p2p.ErrInvalidResponse, batchSize(req), len(results))
+	}
+	c.metrics.ObserveRequests(ctx, 1, p2p.StatusSuccess)
+	return results, nil
+}
+
+func (c *Client) doBatchRequest(
+	ctx context.Context,
+	req *pb.GetSharesByNamespaceBatchRequest,
+	peerID peer.ID,
+) ([]*BatchResult, error) {
+	stream, err := c.sendRequest(ctx, c.batchProtocolID, req, peerID)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	var results []*BatchResult
+	for {
+		var resp pb.NamespaceBatchResponse
+		_, err := serde.Read(stream, &resp)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				if len(results) == 0 {
+					// server is overloaded and closed the stream
+					c.metrics.ObserveRequests(ctx, 1, p2p.StatusRateLimited)
+					return nil, p2p.ErrRateLimited
+				}
+				// all data is received and steam is closed by server
+				return results, nil
+			}
+			c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
+			stream.Reset() //nolint:errcheck
+			return nil, fmt.Errorf("client-nd: reading batch response: %w", err)
+		}
+		if len(results) == batchSize(req) {
+			c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
+			stream.Reset() //nolint:errcheck
+			return nil, fmt.Errorf("%w: more results than requested", p2p.ErrInvalidResponse)
+		}
+
+		result := &BatchResult{
+			RootHash: resp.RootHash,
+			Height:   resp.Height,
+		}
+		switch resp.Status {
+		case pb.StatusCode_OK:
+			for i := uint32(0); i < resp.Rows; i++ {
+				row, err := readRow(stream)
+				if err != nil {
+					c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
+					stream.Reset() //nolint:errcheck
+					return nil, fmt.Errorf("client-nd: reading batch row: %w", err)
+				}
+				result.Shares = append(result.Shares, row)
+			}
+		case pb.StatusCode_NOT_FOUND:
+			result.Err = p2p.ErrNotFound
+		default:
+			result.Err = p2p.ErrInvalidResponse
+		}
+		results = append(results, result)
+	}
+}
+
+// batchRoot is a single root of a batch request. The hash of a requested height is empty if its
+// header is unknown.
+type batchRoot struct {
+	hash   share.DataHash
+	height uint64
+}
+
+func (srv *Server) handleNamespacedDataBatch(ctx context.Context, stream network.Stream) error {
+	logger := log.With("source", "server", "peer", stream.Conn().RemotePeer().String())
+	logger.Debug("handling nd batch request")
+
+	srv.observeRateLimitedRequests()
+	var req pb.GetSharesByNamespaceBatchRequest
+	err := srv.read(logger, stream, &req)
+	if err == nil {
+		err = validateBatchRequest(&req)
+		if err != nil {
+			err = fmt.Errorf("invalid request: %w", err)
+		}
+	}
+	if err != nil {
+		logger.Warnw("read request", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
+		return err
+	}
+
+	logger = logger.With("namespace", share.Namespace(req.Namespace).String(),
+		"roots", len(req.RootHashes), "from", req.FromHeight, "to", req.ToHeight)
+
+	for _, root := range srv.batchRoots(ctx, logger, &req) {
+		err = srv.respondBatchRoot(ctx, logger, stream, root, req.Namespace)
+		if err != nil {
+			logger.Errorw("send nd batch data", "err", err)
+			srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSendRespErr)
+			return err
+		}
+	}
+	return nil
+}
+
+// batchRoots resolves the roots of the batch request.
+func (srv *Server) batchRoots(
+	ctx context.Context,
+	logger *zap.SugaredLogger,
+	req *pb.GetSharesByNamespaceBatchRequest,
+) []batchRoot {
+	roots := make([]batchRoot, 0, batchSize(req))
+	if len(req.RootHashes) != 0 {
+		for _, hash := range req.RootHashes {
+			roots = append(roots, batchRoot{hash: hash})
+		}
+		return roots
+	}
+
+	// heights above the head are reported as not found right away, instead of waiting for them
+	var head uint64
+	if srv.headerGetter != nil {
+		eh, err := srv.headerGetter.Head(ctx)
+		if err != nil {
+			logger.Warnw("getting head", "err", err)
+		} else {
+			head = eh.Height()
+		}
+	}
+	for height := req.FromHeight; height <= req.ToHeight; height++ {
+		root := batchRoot{height: height}
+		if height <= head {
+			eh, err := srv.headerGetter.GetByHeight(ctx, height)
+			if err != nil {
+				logger.Debugw("getting header", "height", height, "err", err)
+			} else {
+				root.hash = eh.DAH.Hash()
+			}
+		}
+		roots = append(roots, root)
+	}
+	return roots
+}
+
+// respondBatchRoot sends the status of a single root of the batch request followed by its rows.
+func (srv *Server) respondBatchRoot(
+	ctx context.Context,
+	logger *zap.SugaredLogger,
+	stream network.Stream,
+	root batchRoot,
+	namespace share.Namespace,
+) error {
+	resp := &pb.NamespaceBatchResponse{
+		RootHash: root.hash,
+		Height:   root.height,
+		Status:   pb.StatusCode_NOT_FOUND,
+	}
+
+	var shares share.NamespacedShares
+	if root.hash != nil {
+		reqCtx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
+		var err error
+		shares, resp.Status, err = srv.getNamespaceData(reqCtx, root.hash, namespace, namespace)
+		cancel()
+		if err != nil {
+			logger.Errorw("handling request", "hash", root.hash.String(), "err", err)
+		}
+	}
+	resp.Rows = uint32(len(shares))
+	srv.observeStatus(ctx, resp.Status)
+
+	err := stream.SetWriteDeadline(time.Now().Add(srv.params.ServerWriteTimeout))
+	if err != nil {
+		logger.Debugw("setting write deadline", "err", err)
+	}
+
+	_, err = serde.Write(stream, resp)
+	if err != nil {
+		return fmt.Errorf("writing response: %w", err)
+	}
+	return srv.sendNamespacedShares(shares, stream)
+}
+
+// validateBatchRequest checks correctness of the batch request
+func validateBatchRequest(req *pb.GetSharesByNamespaceBatchRequest) error {
+	if err := share.Namespace(req.Namespace).ValidateForData(); err != nil {
+		return err
+	}
+
+	if len(req.RootHashes) != 0 {
+		if req.FromHeight != 0 || req.ToHeight != 0 {
+			return errors.New("both root hashes and height range are set")
+		}
+		if len(req.RootHashes) > maxBatchSize {
+			return fmt.Errorf("too many root hashes: %d, max: %d", len(req.RootHashes), maxBatchSize)
+		}
+		for _, hash := range req.RootHashes {
+			if len(hash) != sha256.Size {
+				return fmt.Errorf("incorrect root hash length: %v", len(hash))
+			}
+		}
+		return nil
+	}
+
+	if req.FromHeight == 0 || req.ToHeight < req.FromHeight {
+		return fmt.Errorf("invalid height range: [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+	if req.ToHeight-req.FromHeight >= maxBatchSize {
+		return fmt.Errorf("too many heights: %d, max: %d", req.ToHeight-req.FromHeight+1, maxBatchSize)
+	}
+	return nil
+}
+
+// batchSize returns the amount of roots requested by the valid batch request.
+func batchSize(req *pb.GetSharesByNamespaceBatchRequest) int {
+	if len(req.RootHashes) != 0 {
+		return len(req.RootHashes)
+	}
+	return int(req.ToHeight - req.FromHeight + 1)
+}
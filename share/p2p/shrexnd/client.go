@@ -24,8 +24,10 @@ import (
 // Client implements client side of shrex/nd protocol to obtain namespaced shares data from remote
 // peers.
 type Client struct {
-	params     *Parameters
-	protocolID protocol.ID
+	params          *Parameters
+	protocolID      protocol.ID
+	rangeProtocolID protocol.ID
+	batchProtocolID protocol.ID
 
 	host    host.Host
 	metrics *p2p.Metrics
@@ -38,9 +40,11 @@ func NewClient(params *Parameters, host host.Host) (*Client, error) {
 	}
 
 	return &Client{
-		host:       host,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
-		params:     params,
+		host:            host,
+		protocolID:      p2p.ProtocolID(params.NetworkID(), protocolString),
+		rangeProtocolID: p2p.ProtocolID(params.NetworkID(), rangeProtocolString),
+		batchProtocolID: p2p.ProtocolID(params.NetworkID(), batchProtocolString),
+		params:          params,
 	}, nil
 }
 
@@ -56,13 +60,52 @@ func (c *Client) RequestND(
 		return nil, err
 	}
 
-	shares, err := c.doRequest(ctx, root, namespace, peer)
-	if err == nil {
-		return shares, nil
+	req := &pb.GetSharesByNamespaceRequest{
+		RootHash:  root.Hash(),
+		Namespace: namespace,
+	}
+	return c.request(ctx, c.protocolID, req, peer)
+}
+
+// RequestNDRange requests namespaced data of all the namespaces between min and max, inclusive,
+// from the given peer.
+// Returns NamespacedShares with unverified range proofs against the share.Root.
+func (c *Client) RequestNDRange(
+	ctx context.Context,
+	root *share.Root,
+	min, max share.Namespace,
+	peer peer.ID,
+) (share.NamespacedShares, error) {
+	if err := share.ValidateNamespaceRange(min, max); err != nil {
+		return nil, err
+	}
+
+	req := &pb.GetSharesByNamespaceRangeRequest{
+		RootHash:     root.Hash(),
+		MinNamespace: min,
+		MaxNamespace: max,
 	}
+	return c.request(ctx, c.rangeProtocolID, req, peer)
+}
+
+func (c *Client) request(
+	ctx context.Context,
+	protocolID protocol.ID,
+	req serde.Message,
+	peer peer.ID,
+) (share.NamespacedShares, error) {
+	shares, err := c.doRequest(ctx, protocolID, req, peer)
+	if err != nil {
+		return nil, c.handleRequestErr(ctx, err)
+	}
+	return shares, nil
+}
+
+// handleRequestErr records and converts the error of a failed request.
+func (c *Client) handleRequestErr(ctx context.Context, err error) error {
 	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 		c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
-		return nil, err
+		return err
 	}
 	// some net.Errors also mean the context deadline was exceeded, but yamux/mocknet do not
 	// unwrap to a ctx err
@@ -70,34 +113,47 @@ func (c *Client) RequestND(
 	if errors.As(err, &ne) && ne.Timeout() {
 		if deadline, _ := ctx.Deadline(); deadline.Before(time.Now()) {
 			c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
-			return nil, context.DeadlineExceeded
+			return context.DeadlineExceeded
 		}
 	}
 	if err != p2p.ErrNotFound && err != p2p.ErrRateLimited {
 		log.Warnw("client-nd: peer returned err", "err", err)
 	}
-	return nil, err
+	return err
 }
 
 func (c *Client) doRequest(
 	ctx context.Context,
-	root *share.Root,
-	namespace share.Namespace,
+	protocolID protocol.ID,
+	req serde.Message,
 	peerID peer.ID,
 ) (share.NamespacedShares, error) {
-	stream, err := c.host.NewStream(ctx, peerID, c.protocolID)
+	stream, err := c.sendRequest(ctx, protocolID, req, peerID)
 	if err != nil {
 		return nil, err
 	}
 	defer stream.Close()
 
-	c.setStreamDeadlines(ctx, stream)
+	if err := c.readStatus(ctx, stream); err != nil {
+		return nil, err
+	}
+	return c.readNamespacedShares(ctx, stream)
+}
 
-	req := &pb.GetSharesByNamespaceRequest{
-		RootHash:  root.Hash(),
-		Namespace: namespace,
+// sendRequest opens a stream of the given protocol to the peer and writes the request to it.
+func (c *Client) sendRequest(
+	ctx context.Context,
+	protocolID protocol.ID,
+	req serde.Message,
+	peerID peer.ID,
+) (network.Stream, error) {
+	stream, err := c.host.NewStream(ctx, peerID, protocolID)
+	if err != nil {
+		return nil, err
 	}
 
+	c.setStreamDeadlines(ctx, stream)
+
 	_, err = serde.Write(stream, req)
 	if err != nil {
 		c.metrics.ObserveRequests(ctx, 1, p2p.StatusSendReqErr)
@@ -109,11 +165,7 @@ func (c *Client) doRequest(
 	if err != nil {
 		log.Debugw("client-nd: closing write side of the stream", "err", err)
 	}
-
-	if err := c.readStatus(ctx, stream); err != nil {
-		return nil, err
-	}
-	return c.readNamespacedShares(ctx, stream)
+	return stream, nil
 }
 
 func (c *Client) readStatus(ctx context.Context, stream network.Stream) error {
@@ -140,8 +192,7 @@ func (c *Client) readNamespacedShares(
 ) (share.NamespacedShares, error) {
 	var shares share.NamespacedShares
 	for {
-		var row pb.NamespaceRowResponse
-		_, err := serde.Read(stream, &row)
+		row, err := readRow(stream)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				// all data is received and steam is closed by server
@@ -150,30 +201,40 @@ func (c *Client) readNamespacedShares(
 			c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
 			return nil, err
 		}
-		var proof nmt.Proof
-		if row.Proof != nil {
-			if len(row.Shares) != 0 {
-				proof = nmt.NewInclusionProof(
-					int(row.Proof.Start),
-					int(row.Proof.End),
-					row.Proof.Nodes,
-					row.Proof.IsMaxNamespaceIgnored,
-				)
-			} else {
-				proof = nmt.NewAbsenceProof(
-					int(row.Proof.Start),
-					int(row.Proof.End),
-					row.Proof.Nodes,
-					row.Proof.LeafHash,
-					row.Proof.IsMaxNamespaceIgnored,
-				)
-			}
+		shares = append(shares, row)
+	}
+}
+
+// readRow reads a single proto Row from the stream and converts it to share.NamespacedRow.
+func readRow(stream network.Stream) (share.NamespacedRow, error) {
+	var row pb.NamespaceRowResponse
+	_, err := serde.Read(stream, &row)
+	if err != nil {
+		return share.NamespacedRow{}, err
+	}
+	var proof nmt.Proof
+	if row.Proof != nil {
+		if len(row.Shares) != 0 {
+			proof = nmt.NewInclusionProof(
+				int(row.Proof.Start),
+				int(row.Proof.End),
+				row.Proof.Nodes,
+				row.Proof.IsMaxNamespaceIgnored,
+			)
+		} else {
+			proof = nmt.NewAbsenceProof(
+				int(row.Proof.Start),
+				int(row.Proof.End),
+				row.Proof.Nodes,
+				row.Proof.LeafHash,
+				row.Proof.IsMaxNamespaceIgnored,
+			)
 		}
-		shares = append(shares, share.NamespacedRow{
-			Shares: row.Shares,
-			Proof:  &proof,
-		})
 	}
+	return share.NamespacedRow{
+		Shares: row.Shares,
+		Proof:  &proof,
+	}, nil
 }
 
 func (c *Client) setStreamDeadlines(ctx context.Context, stream network.Stream) {
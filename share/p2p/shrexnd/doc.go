@@ -6,6 +6,9 @@
 // The streams are established using the protocol ID:
 //
 //   - "{networkID}/shrex/nd/0.0.1" where networkID is the network ID of the network. (e.g. "arabica")
+//   - "{networkID}/shrex/nd-range/v0.0.1" for the data of a range of namespaces.
+//   - "{networkID}/shrex/nd-batch/v0.0.1" for the data of a namespace in multiple roots or heights,
+//     served on a single stream.
 //
 // The protocol uses protobuf to serialize and deserialize messages.
 //
@@ -24,14 +27,23 @@
 //
 // where data is of type [share.NamespacedShares]
 //
+// 3. Request data of multiple roots, or of a range of heights, at once by calling
+// [Client.RequestNDBatch] or [Client.RequestNDByHeightRange]:
+//
+//	results, err := client.RequestNDByHeightRange(ctx, headerGetter, from, to, namespaceID, peerID)
+//
+// where results is a slice of [BatchResult], one per height, and headerGetter, of type
+// [libhead.Getter], holds the local headers the roots resolved by the peer are checked against
+//
 // To use a shrexnd server to respond to requests from peers, you must first create a new `shrexnd.Server` instance by:
 //
 // 1. Create a new server using `NewServer` and pass in the parameters of
-// the protocol, the host, the store and store share getter:
+// the protocol, the host, the store and the header getter:
 //
-//	server, err := shrexnd.NewServer(params, host, store, storeShareGetter)
+//	server, err := shrexnd.NewServer(params, host, store, headerGetter)
 //
-// where store is of type [share.Store] and storeShareGetter is of type [share.Getter]
+// where store is of type [eds.Store] and headerGetter, used to resolve the heights of batch
+// requests, is of type [libhead.Getter]
 //
 // 2. Start the server by calling `Start` on the server:
 //
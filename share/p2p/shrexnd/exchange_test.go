@@ -13,6 +13,9 @@ import (
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/require"
 
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/eds/edstest"
@@ -53,6 +56,109 @@ func TestExchange_RequestND_NotFound(t *testing.T) {
 	})
 }
 
+func TestExchange_RequestNDRange(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	edsStore, client, server := makeExchange(t)
+	require.NoError(t, edsStore.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	eds := edstest.RandEDS(t, 4)
+	dah, err := share.NewRoot(eds)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+
+	// the range spans the original shares of the first two rows
+	min, max := share.GetNamespace(eds.GetCell(0, 0)), share.GetNamespace(eds.GetCell(1, 3))
+	shares, err := client.RequestNDRange(ctx, dah, min, max, server.host.ID())
+	require.NoError(t, err)
+	require.NoError(t, shares.VerifyRange(dah, min, max))
+	require.Len(t, shares, 2)
+	require.Len(t, shares.Flatten(), 8)
+
+	// invalid range
+	_, err = client.RequestNDRange(ctx, dah, max, min, server.host.ID())
+	require.Error(t, err)
+}
+
+func TestExchange_RequestNDBatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	edsStore, client, server, getter := makeBatchExchange(t)
+	require.NoError(t, edsStore.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	// every square is filled with the namespace and has a distinct size, as the shares of the same
+	// size are generated with the same seed
+	namespace := sharetest.RandV0Namespace()
+	const stored = 3
+	roots := make([]*share.Root, 0, stored+1)
+	for height := uint64(1); height <= stored; height++ {
+		eds, dah := edstest.RandEDSWithNamespace(t, namespace, 1<<height)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		getter.add(height, dah)
+		roots = append(roots, dah)
+	}
+	// the last root is not stored by the server
+	_, unknown := edstest.RandEDSWithNamespace(t, namespace, 1<<(stored+1))
+	roots = append(roots, unknown)
+
+	// the client has synced all the heights
+	local := &headerGetterStub{headers: make(map[uint64]*header.ExtendedHeader)}
+	for i, root := range roots {
+		local.add(uint64(i+1), root)
+	}
+
+	t.Run("roots", func(t *testing.T) {
+		results, err := client.RequestNDBatch(ctx, roots, namespace, server.host.ID())
+		require.NoError(t, err)
+		require.Len(t, results, len(roots))
+		for i, result := range results[:stored] {
+			require.NoError(t, result.Err)
+			require.EqualValues(t, roots[i].Hash(), result.RootHash)
+			require.NotEmpty(t, result.Shares.Flatten())
+			require.NoError(t, result.Shares.Verify(roots[i], namespace))
+		}
+		require.ErrorIs(t, results[stored].Err, p2p.ErrNotFound)
+	})
+
+	t.Run("height_range", func(t *testing.T) {
+		// the last height is above the head of the server
+		results, err := client.RequestNDByHeightRange(ctx, local, 1, stored+1, namespace, server.host.ID())
+		require.NoError(t, err)
+		require.Len(t, results, stored+1)
+		for i, result := range results[:stored] {
+			require.NoError(t, result.Err)
+			require.EqualValues(t, i+1, result.Height)
+			require.EqualValues(t, roots[i].Hash(), result.RootHash)
+			require.NoError(t, result.Shares.Verify(roots[i], namespace))
+		}
+		require.ErrorIs(t, results[stored].Err, p2p.ErrNotFound)
+		require.Empty(t, results[stored].RootHash)
+	})
+
+	t.Run("height_range_unverified", func(t *testing.T) {
+		// the heights above the local head can't be verified
+		_, err := client.RequestNDByHeightRange(ctx, local, 1, stored+2, namespace, server.host.ID())
+		require.Error(t, err)
+
+		// the root resolved by the peer doesn't match the local header
+		forked := &headerGetterStub{headers: make(map[uint64]*header.ExtendedHeader)}
+		forked.add(1, roots[1])
+		_, err = client.RequestNDByHeightRange(ctx, forked, 1, 1, namespace, server.host.ID())
+		require.ErrorIs(t, err, p2p.ErrInvalidResponse)
+	})
+
+	t.Run("invalid_request", func(t *testing.T) {
+		_, err := client.RequestNDByHeightRange(ctx, local, 2, 1, namespace, server.host.ID())
+		require.Error(t, err)
+		_, err = client.RequestNDByHeightRange(ctx, local, 1, maxBatchSize+1, namespace, server.host.ID())
+		require.Error(t, err)
+		_, err = client.RequestNDBatch(ctx, nil, namespace, server.host.ID())
+		require.Error(t, err)
+	})
+}
+
 func TestExchange_RequestND(t *testing.T) {
 	t.Run("ND_concurrency_limit", func(t *testing.T) {
 		net, err := mocknet.FullMeshConnected(2)
@@ -60,7 +166,7 @@ func TestExchange_RequestND(t *testing.T) {
 
 		client, err := NewClient(DefaultParameters(), net.Hosts()[0])
 		require.NoError(t, err)
-		server, err := NewServer(DefaultParameters(), net.Hosts()[1], nil)
+		server, err := NewServer(DefaultParameters(), net.Hosts()[1], nil, nil)
 		require.NoError(t, err)
 
 		require.NoError(t, server.Start(context.Background()))
@@ -121,14 +227,65 @@ func createMocknet(t *testing.T, amount int) []libhost.Host {
 }
 
 func makeExchange(t *testing.T) (*eds.Store, *Client, *Server) {
+	t.Helper()
+	store, client, server, _ := makeBatchExchange(t)
+	return store, client, server
+}
+
+func makeBatchExchange(t *testing.T) (*eds.Store, *Client, *Server, *headerGetterStub) {
 	t.Helper()
 	store := newStore(t)
 	hosts := createMocknet(t, 2)
+	getter := &headerGetterStub{headers: make(map[uint64]*header.ExtendedHeader)}
 
 	client, err := NewClient(DefaultParameters(), hosts[0])
 	require.NoError(t, err)
-	server, err := NewServer(DefaultParameters(), hosts[1], store)
+	server, err := NewServer(DefaultParameters(), hosts[1], store, getter)
 	require.NoError(t, err)
 
-	return store, client, server
+	return store, client, server, getter
+}
+
+type headerGetterStub struct {
+	head    uint64
+	headers map[uint64]*header.ExtendedHeader
+}
+
+func (g *headerGetterStub) add(height uint64, dah *share.Root) {
+	g.headers[height] = &header.ExtendedHeader{
+		RawHeader: header.RawHeader{Height: int64(height)},
+		DAH:       dah,
+	}
+	g.head = max(g.head, height)
+}
+
+func (g *headerGetterStub) Head(
+	context.Context,
+	...libhead.HeadOption[*header.ExtendedHeader],
+) (*header.ExtendedHeader, error) {
+	eh, ok := g.headers[g.head]
+	if !ok {
+		return nil, libhead.ErrNoHead
+	}
+	return eh, nil
+}
+
+func (g *headerGetterStub) GetByHeight(_ context.Context, height uint64) (*header.ExtendedHeader, error) {
+	eh, ok := g.headers[height]
+	if !ok {
+		return nil, libhead.ErrNotFound
+	}
+	return eh, nil
+}
+
+func (g *headerGetterStub) GetRangeByHeight(
+	context.Context,
+	*header.ExtendedHeader,
+	uint64,
+) ([]*header.ExtendedHeader, error) {
+	return nil, nil
+}
+
+func (g *headerGetterStub) Get(context.Context, libhead.Hash) (*header.ExtendedHeader, error) {
+	return nil, libhead.ErrNotFound
 }
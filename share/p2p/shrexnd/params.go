@@ -8,7 +8,19 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p"
 )
 
-const protocolString = "/shrex/nd/v0.0.3"
+const (
+	protocolString = "/shrex/nd/v0.0.3"
+	// rangeProtocolString is the protocol of the requests of namespace ranges. It is served by the
+	// same server, but has its own protocol to not be confused with the single namespace requests by
+	// the servers that don't support it.
+	rangeProtocolString = "/shrex/nd-range/v0.0.1"
+	// batchProtocolString is the protocol of the requests of a single namespace across multiple
+	// roots on a single stream.
+	batchProtocolString = "/shrex/nd-batch/v0.0.1"
+)
+
+// maxBatchSize is the maximum amount of roots that can be requested in a single batch.
+const maxBatchSize = 128
 
 var log = logging.Logger("shrex/nd")
 
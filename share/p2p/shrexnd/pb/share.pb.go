@@ -106,6 +106,136 @@ func (m *GetSharesByNamespaceRequest) GetNamespace() []byte {
 	return nil
 }
 
+type GetSharesByNamespaceRangeRequest struct {
+	RootHash     []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	MinNamespace []byte `protobuf:"bytes,2,opt,name=min_namespace,json=minNamespace,proto3" json:"min_namespace,omitempty"`
+	MaxNamespace []byte `protobuf:"bytes,3,opt,name=max_namespace,json=maxNamespace,proto3" json:"max_namespace,omitempty"`
+}
+
+func (m *GetSharesByNamespaceRangeRequest) Reset()         { *m = GetSharesByNamespaceRangeRequest{} }
+func (m *GetSharesByNamespaceRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSharesByNamespaceRangeRequest) ProtoMessage()    {}
+func (*GetSharesByNamespaceRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9f13149b0de397, []int{1}
+}
+func (m *GetSharesByNamespaceRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSharesByNamespaceRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSharesByNamespaceRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSharesByNamespaceRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSharesByNamespaceRangeRequest.Merge(m, src)
+}
+func (m *GetSharesByNamespaceRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSharesByNamespaceRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSharesByNamespaceRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSharesByNamespaceRangeRequest proto.InternalMessageInfo
+
+func (m *GetSharesByNamespaceRangeRequest) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *GetSharesByNamespaceRangeRequest) GetMinNamespace() []byte {
+	if m != nil {
+		return m.MinNamespace
+	}
+	return nil
+}
+
+func (m *GetSharesByNamespaceRangeRequest) GetMaxNamespace() []byte {
+	if m != nil {
+		return m.MaxNamespace
+	}
+	return nil
+}
+
+// GetSharesByNamespaceBatchRequest requests the shares of the namespace of either the given roots
+// or the roots of the given inclusive height range.
+type GetSharesByNamespaceBatchRequest struct {
+	RootHashes [][]byte `protobuf:"bytes,1,rep,name=root_hashes,json=rootHashes,proto3" json:"root_hashes,omitempty"`
+	FromHeight uint64   `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64   `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Namespace  []byte   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *GetSharesByNamespaceBatchRequest) Reset()         { *m = GetSharesByNamespaceBatchRequest{} }
+func (m *GetSharesByNamespaceBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSharesByNamespaceBatchRequest) ProtoMessage()    {}
+func (*GetSharesByNamespaceBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9f13149b0de397, []int{2}
+}
+func (m *GetSharesByNamespaceBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSharesByNamespaceBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSharesByNamespaceBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSharesByNamespaceBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSharesByNamespaceBatchRequest.Merge(m, src)
+}
+func (m *GetSharesByNamespaceBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSharesByNamespaceBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSharesByNamespaceBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSharesByNamespaceBatchRequest proto.InternalMessageInfo
+
+func (m *GetSharesByNamespaceBatchRequest) GetRootHashes() [][]byte {
+	if m != nil {
+		return m.RootHashes
+	}
+	return nil
+}
+
+func (m *GetSharesByNamespaceBatchRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetSharesByNamespaceBatchRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetSharesByNamespaceBatchRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
 type GetSharesByNamespaceStatusResponse struct {
 	Status StatusCode `protobuf:"varint,1,opt,name=status,proto3,enum=share.p2p.shrex.nd.StatusCode" json:"status,omitempty"`
 }
@@ -114,7 +244,7 @@ func (m *GetSharesByNamespaceStatusResponse) Reset()         { *m = GetSharesByN
 func (m *GetSharesByNamespaceStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSharesByNamespaceStatusResponse) ProtoMessage()    {}
 func (*GetSharesByNamespaceStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed9f13149b0de397, []int{1}
+	return fileDescriptor_ed9f13149b0de397, []int{3}
 }
 func (m *GetSharesByNamespaceStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +289,7 @@ func (m *NamespaceRowResponse) Reset()         { *m = NamespaceRowResponse{} }
 func (m *NamespaceRowResponse) String() string { return proto.CompactTextString(m) }
 func (*NamespaceRowResponse) ProtoMessage()    {}
 func (*NamespaceRowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed9f13149b0de397, []int{2}
+	return fileDescriptor_ed9f13149b0de397, []int{4}
 }
 func (m *NamespaceRowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,38 +332,120 @@ func (m *NamespaceRowResponse) GetProof() *pb.Proof {
 	return nil
 }
 
+// NamespaceBatchResponse precedes the rows of a single root of a batch. It is followed by the given
+// amount of NamespaceRowResponse messages.
+type NamespaceBatchResponse struct {
+	RootHash []byte     `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	Height   uint64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Status   StatusCode `protobuf:"varint,3,opt,name=status,proto3,enum=share.p2p.shrex.nd.StatusCode" json:"status,omitempty"`
+	Rows     uint32     `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (m *NamespaceBatchResponse) Reset()         { *m = NamespaceBatchResponse{} }
+func (m *NamespaceBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*NamespaceBatchResponse) ProtoMessage()    {}
+func (*NamespaceBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9f13149b0de397, []int{5}
+}
+func (m *NamespaceBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceBatchResponse.Merge(m, src)
+}
+func (m *NamespaceBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceBatchResponse proto.InternalMessageInfo
+
+func (m *NamespaceBatchResponse) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *NamespaceBatchResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *NamespaceBatchResponse) GetStatus() StatusCode {
+	if m != nil {
+		return m.Status
+	}
+	return StatusCode_INVALID
+}
+
+func (m *NamespaceBatchResponse) GetRows() uint32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("share.p2p.shrex.nd.StatusCode", StatusCode_name, StatusCode_value)
 	proto.RegisterType((*GetSharesByNamespaceRequest)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceRequest")
+	proto.RegisterType((*GetSharesByNamespaceRangeRequest)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceRangeRequest")
+	proto.RegisterType((*GetSharesByNamespaceBatchRequest)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceBatchRequest")
 	proto.RegisterType((*GetSharesByNamespaceStatusResponse)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceStatusResponse")
 	proto.RegisterType((*NamespaceRowResponse)(nil), "share.p2p.shrex.nd.NamespaceRowResponse")
+	proto.RegisterType((*NamespaceBatchResponse)(nil), "share.p2p.shrex.nd.NamespaceBatchResponse")
 }
 
 func init() { proto.RegisterFile("share/p2p/shrexnd/pb/share.proto", fileDescriptor_ed9f13149b0de397) }
 
 var fileDescriptor_ed9f13149b0de397 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x4f, 0x4b, 0xf3, 0x40,
-	0x10, 0xc6, 0x93, 0x96, 0x37, 0x6f, 0x3b, 0xad, 0x35, 0x2c, 0x22, 0xc5, 0xca, 0x52, 0x02, 0x42,
-	0xf1, 0xb0, 0x81, 0x08, 0x1e, 0x85, 0xd6, 0xfa, 0xa7, 0x58, 0x52, 0xd9, 0xb6, 0xe2, 0x41, 0x28,
-	0x1b, 0xbb, 0x92, 0x8b, 0xd9, 0x35, 0xbb, 0x45, 0xfd, 0x16, 0x7e, 0x2c, 0x8f, 0x3d, 0x7a, 0x94,
-	0xf6, 0x8b, 0x48, 0xb6, 0xd1, 0x1c, 0xf4, 0xb6, 0xf3, 0xcc, 0x33, 0xbf, 0x7d, 0x66, 0xa0, 0xad,
-	0x62, 0x96, 0x72, 0x5f, 0x06, 0xd2, 0x57, 0x71, 0xca, 0x5f, 0x92, 0xb9, 0x2f, 0x23, 0xdf, 0x88,
-	0x44, 0xa6, 0x42, 0x0b, 0x84, 0xf2, 0x22, 0x90, 0xc4, 0x38, 0x48, 0x32, 0xdf, 0x6b, 0xc8, 0xc8,
-	0x97, 0xa9, 0x10, 0x0f, 0x1b, 0x8f, 0x77, 0x0b, 0xad, 0x0b, 0xae, 0xc7, 0x99, 0x51, 0xf5, 0x5e,
-	0x43, 0xf6, 0xc8, 0x95, 0x64, 0xf7, 0x9c, 0xf2, 0xa7, 0x05, 0x57, 0x1a, 0xb5, 0xa0, 0x9a, 0x0a,
-	0xa1, 0x67, 0x31, 0x53, 0x71, 0xd3, 0x6e, 0xdb, 0x9d, 0x3a, 0xad, 0x64, 0xc2, 0x25, 0x53, 0x31,
-	0xda, 0x87, 0x6a, 0xf2, 0x3d, 0xd0, 0x2c, 0x99, 0x66, 0x21, 0x78, 0x77, 0xe0, 0xfd, 0x45, 0x1e,
-	0x6b, 0xa6, 0x17, 0x8a, 0x72, 0x25, 0x45, 0xa2, 0x38, 0x3a, 0x06, 0x47, 0x19, 0xc5, 0xd0, 0x1b,
-	0x01, 0x26, 0xbf, 0x43, 0x93, 0xcd, 0xcc, 0xa9, 0x98, 0x73, 0x9a, 0xbb, 0xbd, 0x29, 0xec, 0x14,
-	0x61, 0xc5, 0xf3, 0x0f, 0x6f, 0x17, 0x1c, 0x03, 0xc8, 0x78, 0xe5, 0x4e, 0x9d, 0xe6, 0x15, 0x3a,
-	0x80, 0x7f, 0x66, 0x6d, 0x93, 0xb3, 0x16, 0x6c, 0x93, 0xfc, 0x08, 0x11, 0xb9, 0xce, 0x1e, 0x74,
-	0xd3, 0x3d, 0x3c, 0x01, 0x28, 0x3e, 0x43, 0x35, 0xf8, 0x3f, 0x08, 0x6f, 0xba, 0xc3, 0x41, 0xdf,
-	0xb5, 0x90, 0x03, 0xa5, 0xd1, 0x95, 0x6b, 0xa3, 0x2d, 0xa8, 0x86, 0xa3, 0xc9, 0xec, 0x7c, 0x34,
-	0x0d, 0xfb, 0x6e, 0x09, 0xd5, 0xa1, 0x32, 0x08, 0x27, 0x67, 0x34, 0xec, 0x0e, 0xdd, 0x72, 0xaf,
-	0xf9, 0xbe, 0xc2, 0xf6, 0x72, 0x85, 0xed, 0xcf, 0x15, 0xb6, 0xdf, 0xd6, 0xd8, 0x5a, 0xae, 0xb1,
-	0xf5, 0xb1, 0xc6, 0x56, 0xe4, 0x98, 0x7b, 0x1f, 0x7d, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x53,
-	0xb4, 0x86, 0xb7, 0x01, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0x9b, 0x12, 0xda, 0xaf, 0xed, 0xa8, 0x2c, 0x54, 0x55, 0x0c, 0x85, 0x2a, 0x08, 0x69,
+	0xe2, 0x90, 0x48, 0x45, 0xe2, 0x88, 0xb4, 0x32, 0x60, 0x15, 0x53, 0x8a, 0xbc, 0x0d, 0x71, 0x40,
+	0xaa, 0x9c, 0xd5, 0x9b, 0x7b, 0x48, 0x6c, 0x62, 0x4f, 0x2b, 0x3f, 0x81, 0x1b, 0x57, 0x4e, 0xfc,
+	0x1d, 0x8e, 0x3b, 0x72, 0x44, 0xed, 0x1f, 0x41, 0x71, 0x9d, 0x74, 0x2b, 0x03, 0xb1, 0x9b, 0xfd,
+	0xbe, 0xe7, 0x97, 0xf7, 0x9e, 0x1d, 0xe8, 0x2b, 0x4e, 0x33, 0x16, 0xca, 0x81, 0x0c, 0x15, 0xcf,
+	0xd8, 0x3c, 0x9d, 0x86, 0x32, 0x0e, 0x0d, 0x18, 0xc8, 0x4c, 0x68, 0x81, 0xb1, 0xdd, 0x0c, 0x64,
+	0x60, 0x18, 0x41, 0x3a, 0x7d, 0xb0, 0x25, 0xe3, 0x50, 0x66, 0x42, 0x9c, 0xae, 0x38, 0xfe, 0x07,
+	0xd8, 0x7e, 0xc3, 0xf4, 0x61, 0x4e, 0x54, 0xc3, 0xcf, 0x11, 0x4d, 0x98, 0x92, 0xf4, 0x84, 0x11,
+	0xf6, 0xe9, 0x9c, 0x29, 0x8d, 0xb7, 0xa1, 0x91, 0x09, 0xa1, 0x27, 0x9c, 0x2a, 0xde, 0x43, 0x7d,
+	0xb4, 0xd3, 0x22, 0xf5, 0x1c, 0xd8, 0xa7, 0x8a, 0xe3, 0x87, 0xd0, 0x48, 0x8b, 0x03, 0xbd, 0xaa,
+	0x19, 0xae, 0x01, 0xff, 0x0b, 0x82, 0xfe, 0x8d, 0xd2, 0x34, 0x3d, 0xfb, 0x3f, 0xfd, 0xc7, 0xd0,
+	0x4e, 0x66, 0xe9, 0x64, 0xf3, 0x1b, 0xad, 0x64, 0x96, 0x96, 0x6a, 0x86, 0x44, 0xe7, 0x57, 0x48,
+	0x8e, 0x25, 0xd1, 0x79, 0x49, 0xf2, 0xbf, 0xff, 0xc5, 0xcb, 0x90, 0xea, 0x13, 0x5e, 0x78, 0x79,
+	0x04, 0xcd, 0xd2, 0x0b, 0x53, 0x3d, 0xd4, 0x77, 0x76, 0x5a, 0x04, 0x0a, 0x37, 0x4c, 0xe5, 0x84,
+	0xd3, 0x4c, 0x24, 0x13, 0xce, 0x66, 0x67, 0x5c, 0x1b, 0x37, 0x35, 0x02, 0x39, 0xb4, 0x6f, 0x90,
+	0x3c, 0x8d, 0x16, 0xc5, 0xd8, 0x31, 0xe3, 0xba, 0x16, 0x76, 0x78, 0xad, 0xad, 0xda, 0x66, 0x5b,
+	0x1f, 0xc1, 0xbf, 0xc9, 0xe0, 0xa1, 0xa6, 0xfa, 0x5c, 0x11, 0xa6, 0xa4, 0x48, 0x15, 0xc3, 0xcf,
+	0xc1, 0x55, 0x06, 0x31, 0x5d, 0x6d, 0x0d, 0xbc, 0xe0, 0xcf, 0x2b, 0x0e, 0x56, 0x67, 0x5e, 0x8a,
+	0x29, 0x23, 0x96, 0xed, 0x1f, 0xc3, 0xfd, 0x75, 0xff, 0xe2, 0xa2, 0xd4, 0xeb, 0x82, 0x6b, 0x04,
+	0x8a, 0xb4, 0x76, 0x87, 0x9f, 0xc0, 0x1d, 0xf3, 0x48, 0x4c, 0xc6, 0xe6, 0xe0, 0x5e, 0x60, 0x9f,
+	0x4c, 0x1c, 0xbc, 0xcb, 0x17, 0x64, 0x35, 0xf5, 0xbf, 0x21, 0xe8, 0x6e, 0x76, 0x69, 0x95, 0xff,
+	0x79, 0xb1, 0x5d, 0x70, 0xaf, 0x75, 0x68, 0x77, 0x57, 0xe2, 0x39, 0xb7, 0x89, 0x87, 0x31, 0xd4,
+	0x32, 0x71, 0xa1, 0x4c, 0xab, 0x6d, 0x62, 0xd6, 0x4f, 0x5f, 0x00, 0xac, 0x99, 0xb8, 0x09, 0x77,
+	0x47, 0xd1, 0xfb, 0xdd, 0x83, 0xd1, 0x5e, 0xa7, 0x82, 0x5d, 0xa8, 0x8e, 0xdf, 0x76, 0x10, 0x6e,
+	0x43, 0x23, 0x1a, 0x1f, 0x4d, 0x5e, 0x8f, 0x8f, 0xa3, 0xbd, 0x4e, 0x15, 0xb7, 0xa0, 0x3e, 0x8a,
+	0x8e, 0x5e, 0x91, 0x68, 0xf7, 0xa0, 0xe3, 0x0c, 0x7b, 0x3f, 0x16, 0x1e, 0xba, 0x5c, 0x78, 0xe8,
+	0xd7, 0xc2, 0x43, 0x5f, 0x97, 0x5e, 0xe5, 0x72, 0xe9, 0x55, 0x7e, 0x2e, 0xbd, 0x4a, 0xec, 0x9a,
+	0x3f, 0xe7, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x52, 0xd6, 0x7e, 0x81, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GetSharesByNamespaceRequest) Marshal() (dAtA []byte, err error) {
@@ -273,6 +485,99 @@ func (m *GetSharesByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *GetSharesByNamespaceRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSharesByNamespaceRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSharesByNamespaceRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxNamespace) > 0 {
+		i -= len(m.MaxNamespace)
+		copy(dAtA[i:], m.MaxNamespace)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.MaxNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinNamespace) > 0 {
+		i -= len(m.MinNamespace)
+		copy(dAtA[i:], m.MinNamespace)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.MinNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSharesByNamespaceBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSharesByNamespaceBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSharesByNamespaceBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RootHashes) > 0 {
+		for iNdEx := len(m.RootHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RootHashes[iNdEx])
+			copy(dAtA[i:], m.RootHashes[iNdEx])
+			i = encodeVarintShare(dAtA, i, uint64(len(m.RootHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetSharesByNamespaceStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -327,90 +632,473 @@ func (m *NamespaceRowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintShare(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Shares[iNdEx])
-			copy(dAtA[i:], m.Shares[iNdEx])
-			i = encodeVarintShare(dAtA, i, uint64(len(m.Shares[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintShare(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintShare(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rows != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.Rows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintShare(dAtA []byte, offset int, v uint64) int {
+	offset -= sovShare(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetSharesByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSharesByNamespaceRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	l = len(m.MinNamespace)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	l = len(m.MaxNamespace)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSharesByNamespaceBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RootHashes) > 0 {
+		for _, b := range m.RootHashes {
+			l = len(b)
+			n += 1 + l + sovShare(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovShare(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovShare(uint64(m.ToHeight))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSharesByNamespaceStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovShare(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *NamespaceRowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovShare(uint64(l))
+		}
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovShare(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovShare(uint64(m.Status))
+	}
+	if m.Rows != 0 {
+		n += 1 + sovShare(uint64(m.Rows))
+	}
+	return n
+}
+
+func sovShare(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozShare(x uint64) (n int) {
+	return sovShare(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetSharesByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSharesByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSharesByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSharesByNamespaceRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSharesByNamespaceRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSharesByNamespaceRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNamespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinNamespace = append(m.MinNamespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.MinNamespace == nil {
+				m.MinNamespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNamespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxNamespace = append(m.MaxNamespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.MaxNamespace == nil {
+				m.MaxNamespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintShare(dAtA []byte, offset int, v uint64) int {
-	offset -= sovShare(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GetSharesByNamespaceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RootHash)
-	if l > 0 {
-		n += 1 + l + sovShare(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovShare(uint64(l))
-	}
-	return n
-}
-
-func (m *GetSharesByNamespaceStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovShare(uint64(m.Status))
-	}
-	return n
-}
 
-func (m *NamespaceRowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Shares) > 0 {
-		for _, b := range m.Shares {
-			l = len(b)
-			n += 1 + l + sovShare(uint64(l))
-		}
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovShare(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovShare(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozShare(x uint64) (n int) {
-	return sovShare(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetSharesByNamespaceRequest) Unmarshal(dAtA []byte) error {
+func (m *GetSharesByNamespaceBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -433,15 +1121,15 @@ func (m *GetSharesByNamespaceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSharesByNamespaceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSharesByNamespaceBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSharesByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSharesByNamespaceBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHashes", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -468,12 +1156,48 @@ func (m *GetSharesByNamespaceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RootHash == nil {
-				m.RootHash = []byte{}
-			}
+			m.RootHashes = append(m.RootHashes, make([]byte, postIndex-iNdEx))
+			copy(m.RootHashes[len(m.RootHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
@@ -715,6 +1439,147 @@ func (m *NamespaceRowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StatusCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipShare(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,9 +12,11 @@ import (
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"go.uber.org/zap"
 
+	libhead "github.com/celestiaorg/go-header"
 	"github.com/celestiaorg/go-libp2p-messenger/serde"
 	nmt_pb "github.com/celestiaorg/nmt/pb"
 
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/p2p"
@@ -26,11 +28,17 @@ import (
 type Server struct {
 	cancel context.CancelFunc
 
-	host       host.Host
-	protocolID protocol.ID
+	host            host.Host
+	protocolID      protocol.ID
+	rangeProtocolID protocol.ID
+	batchProtocolID protocol.ID
 
-	handler network.StreamHandler
-	store   *eds.Store
+	handler      network.StreamHandler
+	rangeHandler network.StreamHandler
+	batchHandler network.StreamHandler
+	store        *eds.Store
+	// headerGetter resolves the heights of the batch requests of height ranges.
+	headerGetter libhead.Getter[*header.ExtendedHeader]
 
 	params     *Parameters
 	middleware *p2p.Middleware
@@ -38,29 +46,41 @@ type Server struct {
 }
 
 // NewServer creates new Server
-func NewServer(params *Parameters, host host.Host, store *eds.Store) (*Server, error) {
+func NewServer(
+	params *Parameters,
+	host host.Host,
+	store *eds.Store,
+	headerGetter libhead.Getter[*header.ExtendedHeader],
+) (*Server, error) {
 	if err := params.Validate(); err != nil {
 		return nil, fmt.Errorf("shrex-nd: server creation failed: %w", err)
 	}
 
 	srv := &Server{
-		store:      store,
-		host:       host,
-		params:     params,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
-		middleware: p2p.NewMiddleware(params.ConcurrencyLimit),
+		store:           store,
+		headerGetter:    headerGetter,
+		host:            host,
+		params:          params,
+		protocolID:      p2p.ProtocolID(params.NetworkID(), protocolString),
+		rangeProtocolID: p2p.ProtocolID(params.NetworkID(), rangeProtocolString),
+		batchProtocolID: p2p.ProtocolID(params.NetworkID(), batchProtocolString),
+		middleware:      p2p.NewMiddleware(params.ConcurrencyLimit),
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	srv.cancel = cancel
 
-	srv.handler = srv.middleware.RateLimitHandler(srv.streamHandler(ctx))
+	srv.handler = srv.middleware.RateLimitHandler(srv.streamHandler(ctx, srv.handleNamespacedData))
+	srv.rangeHandler = srv.middleware.RateLimitHandler(srv.streamHandler(ctx, srv.handleNamespacedDataRange))
+	srv.batchHandler = srv.middleware.RateLimitHandler(srv.streamHandler(ctx, srv.handleNamespacedDataBatch))
 	return srv, nil
 }
 
 // Start starts the server
 func (srv *Server) Start(context.Context) error {
 	srv.host.SetStreamHandler(srv.protocolID, srv.handler)
+	srv.host.SetStreamHandler(srv.rangeProtocolID, srv.rangeHandler)
+	srv.host.SetStreamHandler(srv.batchProtocolID, srv.batchHandler)
 	return nil
 }
 
@@ -68,12 +88,21 @@ func (srv *Server) Start(context.Context) error {
 func (srv *Server) Stop(context.Context) error {
 	srv.cancel()
 	srv.host.RemoveStreamHandler(srv.protocolID)
+	srv.host.RemoveStreamHandler(srv.rangeProtocolID)
+	srv.host.RemoveStreamHandler(srv.batchProtocolID)
 	return nil
 }
 
-func (srv *Server) streamHandler(ctx context.Context) network.StreamHandler {
+// requestReader reads a request of the single namespace or the namespace range protocol from the
+// stream and converts it into a namespace range request.
+type requestReader func(*zap.SugaredLogger, network.Stream) (*pb.GetSharesByNamespaceRangeRequest, error)
+
+func (srv *Server) streamHandler(
+	ctx context.Context,
+	handle func(context.Context, network.Stream) error,
+) network.StreamHandler {
 	return func(s network.Stream) {
-		err := srv.handleNamespacedData(ctx, s)
+		err := handle(ctx, s)
 		if err != nil {
 			s.Reset() //nolint:errcheck
 			return
@@ -97,24 +126,37 @@ func (srv *Server) observeRateLimitedRequests() {
 }
 
 func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stream) error {
+	return srv.handleRequest(ctx, stream, srv.readRequest)
+}
+
+func (srv *Server) handleNamespacedDataRange(ctx context.Context, stream network.Stream) error {
+	return srv.handleRequest(ctx, stream, srv.readRangeRequest)
+}
+
+func (srv *Server) handleRequest(
+	ctx context.Context,
+	stream network.Stream,
+	readRequest requestReader,
+) error {
 	logger := log.With("source", "server", "peer", stream.Conn().RemotePeer().String())
 	logger.Debug("handling nd request")
 
 	srv.observeRateLimitedRequests()
-	req, err := srv.readRequest(logger, stream)
+	req, err := readRequest(logger, stream)
 	if err != nil {
 		logger.Warnw("read request", "err", err)
 		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
 		return err
 	}
 
-	logger = logger.With("namespace", share.Namespace(req.Namespace).String(),
+	logger = logger.With("min_namespace", share.Namespace(req.MinNamespace).String(),
+		"max_namespace", share.Namespace(req.MaxNamespace).String(),
 		"hash", share.DataHash(req.RootHash).String())
 
 	ctx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
 	defer cancel()
 
-	shares, status, err := srv.getNamespaceData(ctx, req.RootHash, req.Namespace)
+	shares, status, err := srv.getNamespaceData(ctx, req.RootHash, req.MinNamespace, req.MaxNamespace)
 	if err != nil {
 		// server should respond with status regardless if there was an error getting data
 		sendErr := srv.respondStatus(ctx, logger, stream, status)
@@ -142,19 +184,57 @@ func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stre
 	return nil
 }
 
+// readRequest reads a single namespace request, which is served as a range of the namespace.
 func (srv *Server) readRequest(
 	logger *zap.SugaredLogger,
 	stream network.Stream,
-) (*pb.GetSharesByNamespaceRequest, error) {
+) (*pb.GetSharesByNamespaceRangeRequest, error) {
+	var req pb.GetSharesByNamespaceRequest
+	err := srv.read(logger, stream, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeReq := &pb.GetSharesByNamespaceRangeRequest{
+		RootHash:     req.RootHash,
+		MinNamespace: req.Namespace,
+		MaxNamespace: req.Namespace,
+	}
+	err = validateRequest(rangeReq)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	return rangeReq, nil
+}
+
+// readRangeRequest reads a namespace range request.
+func (srv *Server) readRangeRequest(
+	logger *zap.SugaredLogger,
+	stream network.Stream,
+) (*pb.GetSharesByNamespaceRangeRequest, error) {
+	var req pb.GetSharesByNamespaceRangeRequest
+	err := srv.read(logger, stream, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateRequest(&req)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	return &req, nil
+}
+
+// read reads the request of the given type from the stream.
+func (srv *Server) read(logger *zap.SugaredLogger, stream network.Stream, req serde.Message) error {
 	err := stream.SetReadDeadline(time.Now().Add(srv.params.ServerReadTimeout))
 	if err != nil {
 		logger.Debugw("setting read deadline", "err", err)
 	}
 
-	var req pb.GetSharesByNamespaceRequest
-	_, err = serde.Read(stream, &req)
+	_, err = serde.Read(stream, req)
 	if err != nil {
-		return nil, fmt.Errorf("reading request: %w", err)
+		return fmt.Errorf("reading request: %w", err)
 
 	}
 
@@ -163,16 +243,11 @@ func (srv *Server) readRequest(
 	if err != nil {
 		logger.Debugw("closing read side of the stream", "err", err)
 	}
-
-	err = validateRequest(req)
-	if err != nil {
-		return nil, fmt.Errorf("invalid request: %w", err)
-	}
-	return &req, nil
+	return nil
 }
 
 func (srv *Server) getNamespaceData(ctx context.Context,
-	hash share.DataHash, namespace share.Namespace) (share.NamespacedShares, pb.StatusCode, error) {
+	hash share.DataHash, min, max share.Namespace) (share.NamespacedShares, pb.StatusCode, error) {
 	dah, err := srv.store.GetDAH(ctx, hash)
 	if err != nil {
 		if errors.Is(err, eds.ErrNotFound) {
@@ -181,7 +256,7 @@ func (srv *Server) getNamespaceData(ctx context.Context,
 		return nil, pb.StatusCode_INTERNAL, fmt.Errorf("retrieving DAH: %w", err)
 	}
 
-	shares, err := eds.RetrieveNamespaceFromStore(ctx, srv.store, dah, namespace)
+	shares, err := eds.RetrieveNamespaceRangeFromStore(ctx, srv.store, dah, min, max)
 	if err != nil {
 		return nil, pb.StatusCode_INTERNAL, fmt.Errorf("retrieving shares: %w", err)
 	}
@@ -243,8 +318,8 @@ func (srv *Server) observeStatus(ctx context.Context, status pb.StatusCode) {
 }
 
 // validateRequest checks correctness of the request
-func validateRequest(req pb.GetSharesByNamespaceRequest) error {
-	if err := share.Namespace(req.Namespace).ValidateForData(); err != nil {
+func validateRequest(req *pb.GetSharesByNamespaceRangeRequest) error {
+	if err := share.ValidateNamespaceRange(req.MinNamespace, req.MaxNamespace); err != nil {
 		return err
 	}
 	if len(req.RootHash) != sha256.Size {
@@ -0,0 +1,302 @@
+package shrexsample
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+
+	"github.com/celestiaorg/go-libp2p-messenger/serde"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexsample/pb"
+)
+
+// Client implements client side of shrex/sample, shrex/samples and shrex/row protocols to obtain
+// single shares, batches of shares and halves of rows or columns of the extended data square from
+// remote peers.
+type Client struct {
+	params            *Parameters
+	protocolID        protocol.ID
+	samplesProtocolID protocol.ID
+	rowProtocolID     protocol.ID
+
+	host    host.Host
+	metrics *p2p.Metrics
+}
+
+// NewClient creates a new shrEx/sample client
+func NewClient(params *Parameters, host host.Host) (*Client, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("shrex-sample: client creation failed: %w", err)
+	}
+
+	return &Client{
+		host:              host,
+		protocolID:        p2p.ProtocolID(params.NetworkID(), protocolString),
+		samplesProtocolID: p2p.ProtocolID(params.NetworkID(), samplesProtocolString),
+		rowProtocolID:     p2p.ProtocolID(params.NetworkID(), rowProtocolString),
+		params:            params,
+	}, nil
+}
+
+// RequestSample requests the share at the given coordinates of the extended data square from the
+// given peer.
+// Returns the Sample with unverified inclusion proof against the share.Root.
+func (c *Client) RequestSample(
+	ctx context.Context,
+	root *share.Root,
+	row, col int,
+	peer peer.ID,
+) (*Sample, error) {
+	width := len(root.RowRoots)
+	if row < 0 || col < 0 || row >= width || col >= width {
+		return nil, share.ErrOutOfBounds
+	}
+
+	req := &pb.GetSampleRequest{
+		RootHash: root.Hash(),
+		Row:      uint32(row),
+		Col:      uint32(col),
+	}
+	var resp pb.GetSampleResponse
+	err := c.request(ctx, c.protocolID, req, &resp, peer)
+	if err != nil {
+		return nil, c.handleRequestErr(ctx, err)
+	}
+	if resp.Proof == nil {
+		return nil, fmt.Errorf("%w: missing proof", p2p.ErrInvalidResponse)
+	}
+
+	proof := nmt.NewInclusionProof(
+		int(resp.Proof.Start),
+		int(resp.Proof.End),
+		resp.Proof.Nodes,
+		resp.Proof.IsMaxNamespaceIgnored,
+	)
+	return &Sample{
+		Share: resp.Share,
+		Proof: &proof,
+	}, nil
+}
+
+// RequestSamples requests the shares at the given coordinates of the extended data square from the
+// given peer in a single round trip. At most MaxSamplesPerRequest shares can be requested at once.
+// Returns the Samples with unverified inclusion proofs against the share.Root, in the order of the
+// given coordinates.
+func (c *Client) RequestSamples(
+	ctx context.Context,
+	root *share.Root,
+	samples []share.Sample,
+	peer peer.ID,
+) ([]*Sample, error) {
+	if len(samples) == 0 || len(samples) > MaxSamplesPerRequest {
+		return nil, fmt.Errorf("amount of samples must be in range [1, %d], got %d",
+			MaxSamplesPerRequest, len(samples))
+	}
+	if err := share.ValidateSamples(samples, len(root.RowRoots)); err != nil {
+		return nil, err
+	}
+
+	req := &pb.GetSamplesRequest{
+		RootHash: root.Hash(),
+		Coords:   make([]*pb.SampleCoords, len(samples)),
+	}
+	for i, s := range samples {
+		req.Coords[i] = &pb.SampleCoords{Row: uint32(s.Row), Col: uint32(s.Col)}
+	}
+	var resp pb.GetSamplesResponse
+	err := c.request(ctx, c.samplesProtocolID, req, &resp, peer)
+	if err != nil {
+		return nil, c.handleRequestErr(ctx, err)
+	}
+	if len(resp.Samples) != len(samples) {
+		return nil, fmt.Errorf("%w: expected %d samples, got %d",
+			p2p.ErrInvalidResponse, len(samples), len(resp.Samples))
+	}
+
+	proved := make([]*Sample, len(resp.Samples))
+	for i, s := range resp.Samples {
+		if s.Proof == nil {
+			return nil, fmt.Errorf("%w: missing proof", p2p.ErrInvalidResponse)
+		}
+		proof := nmt.NewInclusionProof(
+			int(s.Proof.Start),
+			int(s.Proof.End),
+			s.Proof.Nodes,
+			s.Proof.IsMaxNamespaceIgnored,
+		)
+		proved[i] = &Sample{
+			Share: s.Share,
+			Proof: &proof,
+		}
+	}
+	return proved, nil
+}
+
+// RequestRow requests the first half of the row or column with the given index of the extended
+// data square from the given peer.
+// Returns the AxisHalf with unverified inclusion proof against the share.Root.
+func (c *Client) RequestRow(
+	ctx context.Context,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+	peer peer.ID,
+) (*share.AxisHalf, error) {
+	if idx < 0 || idx >= len(share.AxisRoots(root, axis)) {
+		return nil, share.ErrOutOfBounds
+	}
+
+	req := &pb.GetRowRequest{
+		RootHash: root.Hash(),
+		Index:    uint32(idx),
+		Axis:     pb.Axis(axis),
+	}
+	var resp pb.GetRowResponse
+	err := c.request(ctx, c.rowProtocolID, req, &resp, peer)
+	if err != nil {
+		return nil, c.handleRequestErr(ctx, err)
+	}
+	if resp.Proof == nil {
+		return nil, fmt.Errorf("%w: missing proof", p2p.ErrInvalidResponse)
+	}
+
+	proof := nmt.NewInclusionProof(
+		int(resp.Proof.Start),
+		int(resp.Proof.End),
+		resp.Proof.Nodes,
+		resp.Proof.IsMaxNamespaceIgnored,
+	)
+	return &share.AxisHalf{
+		Shares: resp.Shares,
+		Proof:  &proof,
+	}, nil
+}
+
+// response is a response message of any of the protocols.
+type response interface {
+	serde.Message
+	GetStatus() pb.StatusCode
+}
+
+// request sends the request to the peer over a new stream of the given protocol and reads the
+// response into resp.
+func (c *Client) request(
+	ctx context.Context,
+	protocolID protocol.ID,
+	req serde.Message,
+	resp response,
+	peerID peer.ID,
+) error {
+	stream, err := c.host.NewStream(ctx, peerID, protocolID)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	c.setStreamDeadlines(ctx, stream)
+
+	_, err = serde.Write(stream, req)
+	if err != nil {
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusSendReqErr)
+		stream.Reset() //nolint:errcheck
+		return fmt.Errorf("client-sample: writing request: %w", err)
+	}
+
+	err = stream.CloseWrite()
+	if err != nil {
+		log.Debugw("client-sample: closing write side of the stream", "err", err)
+	}
+
+	_, err = serde.Read(stream, resp)
+	if err != nil {
+		// server is overloaded and closed the stream
+		if errors.Is(err, io.EOF) {
+			c.metrics.ObserveRequests(ctx, 1, p2p.StatusRateLimited)
+			return p2p.ErrRateLimited
+		}
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
+		stream.Reset() //nolint:errcheck
+		return fmt.Errorf("client-sample: reading response: %w", err)
+	}
+
+	return c.convertStatusToErr(ctx, resp.GetStatus())
+}
+
+// handleRequestErr records and converts the error of a failed request.
+func (c *Client) handleRequestErr(ctx context.Context, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
+		return err
+	}
+	// some net.Errors also mean the context deadline was exceeded, but yamux/mocknet do not
+	// unwrap to a ctx err
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		if deadline, _ := ctx.Deadline(); deadline.Before(time.Now()) {
+			c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
+			return context.DeadlineExceeded
+		}
+	}
+	if err != p2p.ErrNotFound && err != p2p.ErrRateLimited {
+		log.Warnw("client-sample: peer returned err", "err", err)
+	}
+	return err
+}
+
+func (c *Client) setStreamDeadlines(ctx context.Context, stream network.Stream) {
+	// set read/write deadline to use context deadline if it exists
+	deadline, ok := ctx.Deadline()
+	if ok {
+		err := stream.SetDeadline(deadline)
+		if err == nil {
+			return
+		}
+		log.Debugw("client-sample: set stream deadline", "err", err)
+	}
+
+	// if deadline not set, client read deadline defaults to server write deadline
+	if c.params.ServerWriteTimeout != 0 {
+		err := stream.SetReadDeadline(time.Now().Add(c.params.ServerWriteTimeout))
+		if err != nil {
+			log.Debugw("client-sample: set read deadline", "err", err)
+		}
+	}
+
+	// if deadline not set, client write deadline defaults to server read deadline
+	if c.params.ServerReadTimeout != 0 {
+		err := stream.SetWriteDeadline(time.Now().Add(c.params.ServerReadTimeout))
+		if err != nil {
+			log.Debugw("client-sample: set write deadline", "err", err)
+		}
+	}
+}
+
+func (c *Client) convertStatusToErr(ctx context.Context, status pb.StatusCode) error {
+	switch status {
+	case pb.StatusCode_OK:
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusSuccess)
+		return nil
+	case pb.StatusCode_NOT_FOUND:
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusNotFound)
+		return p2p.ErrNotFound
+	case pb.StatusCode_INVALID:
+		log.Warn("client-sample: invalid request")
+		fallthrough
+	case pb.StatusCode_INTERNAL:
+		fallthrough
+	default:
+		return p2p.ErrInvalidResponse
+	}
+}
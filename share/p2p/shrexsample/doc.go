@@ -0,0 +1,71 @@
+// This package defines protocols that are used to request single shares, batches of shares and
+// halves of rows or columns of the extended data square from peers in the network.
+//
+// This protocol is a request/response protocol that sends a request for the share at the given
+// row and column of the square of a data root and receives a response with the share and its
+// NMT inclusion proof against the root of its row. It allows light nodes to sample the data from
+// full and bridge nodes without falling back to bitswap.
+//
+// The samples protocol is the batched version of the sample one. It sends a request for up to
+// [MaxSamplesPerRequest] coordinates of the square of a data root and receives a response with
+// the shares and their proofs in the order of the requested coordinates, so that all the samples
+// of a header are retrieved in a single round trip.
+//
+// The row protocol sends a request for the row or column with the given index of the square and
+// receives a response with the shares of its first half and their NMT inclusion proof against the
+// root of the axis. The second half is recovered by erasure coding the first one.
+//
+// The streams are established using the protocol IDs:
+//
+//   - "{networkID}/shrex/sample/0.0.1" where networkID is the network ID of the network. (e.g. "arabica")
+//   - "{networkID}/shrex/samples/0.0.1" for the batched sample requests.
+//   - "{networkID}/shrex/row/0.0.1" for the row and column requests.
+//
+// The protocol uses protobuf to serialize and deserialize messages.
+//
+// # Usage
+//
+// To use a shrexsample client to request a share from a peer, you must first create a new
+// `shrexsample.Client` instance by:
+//
+// 1. Create a new client using `NewClient` and pass in the parameters of the protocol and the host:
+//
+//	client, err := shrexsample.NewClient(params, host)
+//
+// 2. Request the share from a peer by calling [Client.RequestSample] on the client and
+// pass in the context, the data root, the coordinates of the share and the peer ID:
+//
+//	sample, err := client.RequestSample(ctx, dataRoot, row, col, peerID)
+//
+// 3. Verify the received share against the data root by calling [Sample.Verify]:
+//
+//	err := sample.Verify(dataRoot, row, col)
+//
+// Batches of shares are requested with [Client.RequestSamples] and verified one by one:
+//
+//	samples, err := client.RequestSamples(ctx, dataRoot, []share.Sample{{Row: row, Col: col}}, peerID)
+//
+// Halves of rows and columns are requested and verified the same way with [Client.RequestRow]
+// and [share.AxisHalf.Verify]:
+//
+//	half, err := client.RequestRow(ctx, dataRoot, idx, rsmt2d.Row, peerID)
+//	err = half.Verify(dataRoot, idx, rsmt2d.Row)
+//
+// To use a shrexsample server to respond to requests from peers, you must first create a new
+// `shrexsample.Server` instance by:
+//
+// 1. Create a new server using `NewServer` and pass in the parameters of
+// the protocol, the host and the store:
+//
+//	server, err := shrexsample.NewServer(params, host, store)
+//
+// where store is of type [eds.Store]
+//
+// 2. Start the server by calling `Start` on the server:
+//
+//	err := server.Start(ctx)
+//
+// 3. Stop the server by calling `Stop` on the server:
+//
+//	err := server.Stop(ctx)
+package shrexsample
@@ -0,0 +1,170 @@
+package shrexsample
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+)
+
+func TestExchange_RequestSample(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	store, client, server := makeExchange(t)
+	require.NoError(t, store.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	square := edstest.RandEDS(t, 4)
+	dah, err := share.NewRoot(square)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, dah.Hash(), square))
+
+	t.Run("all_coordinates", func(t *testing.T) {
+		width := int(square.Width())
+		for row := 0; row < width; row++ {
+			for col := 0; col < width; col++ {
+				sample, err := client.RequestSample(ctx, dah, row, col, server.host.ID())
+				require.NoError(t, err)
+				require.Equal(t, square.GetCell(uint(row), uint(col)), sample.Share)
+				require.NoError(t, sample.Verify(dah, row, col))
+				// the proof must not be accepted for any other share
+				require.Error(t, sample.Verify(dah, row, (col+1)%width))
+				require.Error(t, sample.Verify(dah, (row+1)%width, col))
+			}
+		}
+	})
+
+	t.Run("out_of_bounds", func(t *testing.T) {
+		_, err := client.RequestSample(ctx, dah, len(dah.RowRoots), 0, server.host.ID())
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		unknown, err := share.NewRoot(edstest.RandEDS(t, 4))
+		require.NoError(t, err)
+		_, err = client.RequestSample(ctx, unknown, 0, 0, server.host.ID())
+		require.ErrorIs(t, err, p2p.ErrNotFound)
+	})
+}
+
+func TestExchange_RequestSamples(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	store, client, server := makeExchange(t)
+	require.NoError(t, store.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	square := edstest.RandEDS(t, 4)
+	dah, err := share.NewRoot(square)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, dah.Hash(), square))
+
+	t.Run("all_coordinates", func(t *testing.T) {
+		width := int(square.Width())
+		samples := make([]share.Sample, 0, width*width)
+		for row := 0; row < width; row++ {
+			for col := 0; col < width; col++ {
+				samples = append(samples, share.Sample{Row: row, Col: col})
+			}
+		}
+
+		proved, err := client.RequestSamples(ctx, dah, samples, server.host.ID())
+		require.NoError(t, err)
+		require.Len(t, proved, len(samples))
+		for i, s := range samples {
+			require.Equal(t, square.GetCell(uint(s.Row), uint(s.Col)), proved[i].Share)
+			require.NoError(t, proved[i].Verify(dah, s.Row, s.Col))
+		}
+	})
+
+	t.Run("too_many_samples", func(t *testing.T) {
+		samples := make([]share.Sample, MaxSamplesPerRequest+1)
+		_, err := client.RequestSamples(ctx, dah, samples, server.host.ID())
+		require.Error(t, err)
+	})
+
+	t.Run("out_of_bounds", func(t *testing.T) {
+		samples := []share.Sample{{Row: 0, Col: 0}, {Row: 0, Col: len(dah.RowRoots)}}
+		_, err := client.RequestSamples(ctx, dah, samples, server.host.ID())
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		unknown, err := share.NewRoot(edstest.RandEDS(t, 4))
+		require.NoError(t, err)
+		_, err = client.RequestSamples(ctx, unknown, []share.Sample{{Row: 1, Col: 1}}, server.host.ID())
+		require.ErrorIs(t, err, p2p.ErrNotFound)
+	})
+}
+
+func TestExchange_RequestRow(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	store, client, server := makeExchange(t)
+	require.NoError(t, store.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	square := edstest.RandEDS(t, 4)
+	dah, err := share.NewRoot(square)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, dah.Hash(), square))
+
+	t.Run("all_axes", func(t *testing.T) {
+		width := int(square.Width())
+		for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+			for idx := 0; idx < width; idx++ {
+				half, err := client.RequestRow(ctx, dah, idx, axis, server.host.ID())
+				require.NoError(t, err)
+				require.NoError(t, half.Verify(dah, idx, axis))
+				// the proof must not be accepted for any other row or column
+				require.Error(t, half.Verify(dah, (idx+1)%width, axis))
+
+				expected, err := eds.ProveAxisHalf(square, idx, axis)
+				require.NoError(t, err)
+				require.Equal(t, expected.Shares, half.Shares)
+			}
+		}
+	})
+
+	t.Run("out_of_bounds", func(t *testing.T) {
+		_, err := client.RequestRow(ctx, dah, len(dah.RowRoots), rsmt2d.Row, server.host.ID())
+		require.ErrorIs(t, err, share.ErrOutOfBounds)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		unknown, err := share.NewRoot(edstest.RandEDS(t, 4))
+		require.NoError(t, err)
+		_, err = client.RequestRow(ctx, unknown, 0, rsmt2d.Col, server.host.ID())
+		require.ErrorIs(t, err, p2p.ErrNotFound)
+	})
+}
+
+func makeExchange(t *testing.T) (*eds.Store, *Client, *Server) {
+	t.Helper()
+	storeCfg := eds.DefaultParameters()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	store, err := eds.NewStore(storeCfg, t.TempDir(), ds)
+	require.NoError(t, err)
+
+	net, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err)
+	hosts := net.Hosts()
+
+	client, err := NewClient(DefaultParameters(), hosts[0])
+	require.NoError(t, err)
+	server, err := NewServer(DefaultParameters(), hosts[1], store)
+	require.NoError(t, err)
+
+	return store, client, server
+}
@@ -0,0 +1,50 @@
+package shrexsample
+
+import (
+	"fmt"
+
+	logging "github.com/ipfs/go-log/v2"
+
+	"github.com/celestiaorg/celestia-node/share/p2p"
+)
+
+const (
+	protocolString = "/shrex/sample/v0.0.1"
+	// rowProtocolString is the protocol of the requests of halves of rows or columns. It is served
+	// by the same server as the samples.
+	rowProtocolString = "/shrex/row/v0.0.1"
+	// samplesProtocolString is the protocol of the batched requests of multiple shares of a single
+	// square. It is served by the same server as the samples.
+	samplesProtocolString = "/shrex/samples/v0.0.1"
+)
+
+// MaxSamplesPerRequest is the maximum amount of shares that can be requested in a single batched
+// request.
+const MaxSamplesPerRequest = 256
+
+var log = logging.Logger("shrex/sample")
+
+// Parameters is the set of parameters that must be configured for the shrex/sample protocol.
+type Parameters = p2p.Parameters
+
+func DefaultParameters() *Parameters {
+	return p2p.DefaultParameters()
+}
+
+func (c *Client) WithMetrics() error {
+	metrics, err := p2p.InitClientMetrics("sample")
+	if err != nil {
+		return fmt.Errorf("shrex/sample: init Metrics: %w", err)
+	}
+	c.metrics = metrics
+	return nil
+}
+
+func (srv *Server) WithMetrics() error {
+	metrics, err := p2p.InitServerMetrics("sample")
+	if err != nil {
+		return fmt.Errorf("shrex/sample: init Metrics: %w", err)
+	}
+	srv.metrics = metrics
+	return nil
+}
@@ -0,0 +1,2122 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: share/p2p/shrexsample/pb/sample.proto
+
+package share_p2p_shrex_sample
+
+import (
+	fmt "fmt"
+	pb "github.com/celestiaorg/nmt/pb"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StatusCode int32
+
+const (
+	StatusCode_INVALID   StatusCode = 0
+	StatusCode_OK        StatusCode = 1
+	StatusCode_NOT_FOUND StatusCode = 2
+	StatusCode_INTERNAL  StatusCode = 3
+)
+
+var StatusCode_name = map[int32]string{
+	0: "INVALID",
+	1: "OK",
+	2: "NOT_FOUND",
+	3: "INTERNAL",
+}
+
+var StatusCode_value = map[string]int32{
+	"INVALID":   0,
+	"OK":        1,
+	"NOT_FOUND": 2,
+	"INTERNAL":  3,
+}
+
+func (x StatusCode) String() string {
+	return proto.EnumName(StatusCode_name, int32(x))
+}
+
+func (StatusCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{0}
+}
+
+type Axis int32
+
+const (
+	Axis_ROW Axis = 0
+	Axis_COL Axis = 1
+)
+
+var Axis_name = map[int32]string{
+	0: "ROW",
+	1: "COL",
+}
+
+var Axis_value = map[string]int32{
+	"ROW": 0,
+	"COL": 1,
+}
+
+func (x Axis) String() string {
+	return proto.EnumName(Axis_name, int32(x))
+}
+
+func (Axis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{1}
+}
+
+type GetSampleRequest struct {
+	RootHash []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	Row      uint32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Col      uint32 `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (m *GetSampleRequest) Reset()         { *m = GetSampleRequest{} }
+func (m *GetSampleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSampleRequest) ProtoMessage()    {}
+func (*GetSampleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{0}
+}
+func (m *GetSampleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSampleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSampleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSampleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSampleRequest.Merge(m, src)
+}
+func (m *GetSampleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSampleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSampleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSampleRequest proto.InternalMessageInfo
+
+func (m *GetSampleRequest) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *GetSampleRequest) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *GetSampleRequest) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+// GetSampleResponse carries the share along with its inclusion proof against the root of its row.
+type GetSampleResponse struct {
+	Status StatusCode `protobuf:"varint,1,opt,name=status,proto3,enum=share.p2p.shrex.sample.StatusCode" json:"status,omitempty"`
+	Share  []byte     `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+	Proof  *pb.Proof  `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *GetSampleResponse) Reset()         { *m = GetSampleResponse{} }
+func (m *GetSampleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSampleResponse) ProtoMessage()    {}
+func (*GetSampleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{1}
+}
+func (m *GetSampleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSampleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSampleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSampleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSampleResponse.Merge(m, src)
+}
+func (m *GetSampleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSampleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSampleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSampleResponse proto.InternalMessageInfo
+
+func (m *GetSampleResponse) GetStatus() StatusCode {
+	if m != nil {
+		return m.Status
+	}
+	return StatusCode_INVALID
+}
+
+func (m *GetSampleResponse) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *GetSampleResponse) GetProof() *pb.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type GetRowRequest struct {
+	RootHash []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	Index    uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Axis     Axis   `protobuf:"varint,3,opt,name=axis,proto3,enum=share.p2p.shrex.sample.Axis" json:"axis,omitempty"`
+}
+
+func (m *GetRowRequest) Reset()         { *m = GetRowRequest{} }
+func (m *GetRowRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRowRequest) ProtoMessage()    {}
+func (*GetRowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{2}
+}
+func (m *GetRowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRowRequest.Merge(m, src)
+}
+func (m *GetRowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRowRequest proto.InternalMessageInfo
+
+func (m *GetRowRequest) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *GetRowRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *GetRowRequest) GetAxis() Axis {
+	if m != nil {
+		return m.Axis
+	}
+	return Axis_ROW
+}
+
+// GetRowResponse carries the first half of the shares of the row or column along with their
+// inclusion proof against the root of the axis.
+type GetRowResponse struct {
+	Status StatusCode `protobuf:"varint,1,opt,name=status,proto3,enum=share.p2p.shrex.sample.StatusCode" json:"status,omitempty"`
+	Shares [][]byte   `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	Proof  *pb.Proof  `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *GetRowResponse) Reset()         { *m = GetRowResponse{} }
+func (m *GetRowResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRowResponse) ProtoMessage()    {}
+func (*GetRowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{3}
+}
+func (m *GetRowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRowResponse.Merge(m, src)
+}
+func (m *GetRowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRowResponse proto.InternalMessageInfo
+
+func (m *GetRowResponse) GetStatus() StatusCode {
+	if m != nil {
+		return m.Status
+	}
+	return StatusCode_INVALID
+}
+
+func (m *GetRowResponse) GetShares() [][]byte {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *GetRowResponse) GetProof() *pb.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type SampleCoords struct {
+	Row uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Col uint32 `protobuf:"varint,2,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (m *SampleCoords) Reset()         { *m = SampleCoords{} }
+func (m *SampleCoords) String() string { return proto.CompactTextString(m) }
+func (*SampleCoords) ProtoMessage()    {}
+func (*SampleCoords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{4}
+}
+func (m *SampleCoords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SampleCoords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SampleCoords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SampleCoords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SampleCoords.Merge(m, src)
+}
+func (m *SampleCoords) XXX_Size() int {
+	return m.Size()
+}
+func (m *SampleCoords) XXX_DiscardUnknown() {
+	xxx_messageInfo_SampleCoords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SampleCoords proto.InternalMessageInfo
+
+func (m *SampleCoords) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *SampleCoords) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+type GetSamplesRequest struct {
+	RootHash []byte          `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	Coords   []*SampleCoords `protobuf:"bytes,2,rep,name=coords,proto3" json:"coords,omitempty"`
+}
+
+func (m *GetSamplesRequest) Reset()         { *m = GetSamplesRequest{} }
+func (m *GetSamplesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSamplesRequest) ProtoMessage()    {}
+func (*GetSamplesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{5}
+}
+func (m *GetSamplesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSamplesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSamplesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSamplesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSamplesRequest.Merge(m, src)
+}
+func (m *GetSamplesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSamplesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSamplesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSamplesRequest proto.InternalMessageInfo
+
+func (m *GetSamplesRequest) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *GetSamplesRequest) GetCoords() []*SampleCoords {
+	if m != nil {
+		return m.Coords
+	}
+	return nil
+}
+
+// SampleProof carries a single share along with its inclusion proof against the root of its row.
+type SampleProof struct {
+	Share []byte    `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	Proof *pb.Proof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *SampleProof) Reset()         { *m = SampleProof{} }
+func (m *SampleProof) String() string { return proto.CompactTextString(m) }
+func (*SampleProof) ProtoMessage()    {}
+func (*SampleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{6}
+}
+func (m *SampleProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SampleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SampleProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SampleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SampleProof.Merge(m, src)
+}
+func (m *SampleProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *SampleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SampleProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SampleProof proto.InternalMessageInfo
+
+func (m *SampleProof) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *SampleProof) GetProof() *pb.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// GetSamplesResponse carries the requested shares in the order of the requested coordinates.
+type GetSamplesResponse struct {
+	Status  StatusCode     `protobuf:"varint,1,opt,name=status,proto3,enum=share.p2p.shrex.sample.StatusCode" json:"status,omitempty"`
+	Samples []*SampleProof `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *GetSamplesResponse) Reset()         { *m = GetSamplesResponse{} }
+func (m *GetSamplesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSamplesResponse) ProtoMessage()    {}
+func (*GetSamplesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c4aeef174de0b75, []int{7}
+}
+func (m *GetSamplesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSamplesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSamplesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSamplesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSamplesResponse.Merge(m, src)
+}
+func (m *GetSamplesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSamplesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSamplesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSamplesResponse proto.InternalMessageInfo
+
+func (m *GetSamplesResponse) GetStatus() StatusCode {
+	if m != nil {
+		return m.Status
+	}
+	return StatusCode_INVALID
+}
+
+func (m *GetSamplesResponse) GetSamples() []*SampleProof {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("share.p2p.shrex.sample.StatusCode", StatusCode_name, StatusCode_value)
+	proto.RegisterEnum("share.p2p.shrex.sample.Axis", Axis_name, Axis_value)
+	proto.RegisterType((*GetSampleRequest)(nil), "share.p2p.shrex.sample.GetSampleRequest")
+	proto.RegisterType((*GetSampleResponse)(nil), "share.p2p.shrex.sample.GetSampleResponse")
+	proto.RegisterType((*GetRowRequest)(nil), "share.p2p.shrex.sample.GetRowRequest")
+	proto.RegisterType((*GetRowResponse)(nil), "share.p2p.shrex.sample.GetRowResponse")
+	proto.RegisterType((*SampleCoords)(nil), "share.p2p.shrex.sample.SampleCoords")
+	proto.RegisterType((*GetSamplesRequest)(nil), "share.p2p.shrex.sample.GetSamplesRequest")
+	proto.RegisterType((*SampleProof)(nil), "share.p2p.shrex.sample.SampleProof")
+	proto.RegisterType((*GetSamplesResponse)(nil), "share.p2p.shrex.sample.GetSamplesResponse")
+}
+
+func init() {
+	proto.RegisterFile("share/p2p/shrexsample/pb/sample.proto", fileDescriptor_7c4aeef174de0b75)
+}
+
+var fileDescriptor_7c4aeef174de0b75 = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xf5, 0xda, 0x8d, 0xd3, 0x4e, 0x9c, 0x60, 0x56, 0x55, 0x65, 0x01, 0xb2, 0x22, 0x43, 0xa5,
+	0xa8, 0x07, 0x1b, 0x99, 0x1b, 0x02, 0xa4, 0x90, 0x42, 0x09, 0x44, 0x36, 0xda, 0x14, 0x38, 0x56,
+	0x4e, 0xb2, 0xc8, 0x91, 0x42, 0x76, 0xf1, 0xba, 0x6a, 0xfe, 0x03, 0x48, 0xf0, 0xb3, 0x38, 0xf6,
+	0xc8, 0x11, 0x25, 0x7f, 0x04, 0x79, 0xd7, 0x51, 0x2c, 0xf5, 0x43, 0x39, 0xf4, 0x36, 0x3b, 0xf3,
+	0x66, 0xe6, 0xbd, 0x79, 0x36, 0x1c, 0x8a, 0x34, 0xc9, 0x68, 0xc0, 0x43, 0x1e, 0x88, 0x34, 0xa3,
+	0x0b, 0x91, 0x7c, 0xe3, 0x33, 0x1a, 0xf0, 0x51, 0xa0, 0x22, 0x9f, 0x67, 0x2c, 0x67, 0xf8, 0x40,
+	0xc2, 0x7c, 0x1e, 0x72, 0x5f, 0xc2, 0x7c, 0x55, 0x7d, 0xd0, 0xe2, 0xa3, 0x80, 0x67, 0x8c, 0x7d,
+	0x55, 0x38, 0x6f, 0x08, 0xf6, 0x09, 0xcd, 0x87, 0xb2, 0x48, 0xe8, 0xf7, 0x73, 0x2a, 0x72, 0xfc,
+	0x10, 0xf6, 0x32, 0xc6, 0xf2, 0xb3, 0x34, 0x11, 0xa9, 0x83, 0xda, 0xa8, 0x63, 0x91, 0xdd, 0x22,
+	0xf1, 0x2e, 0x11, 0x29, 0xb6, 0xc1, 0xc8, 0xd8, 0x85, 0xa3, 0xb7, 0x51, 0xa7, 0x49, 0x8a, 0xb0,
+	0xc8, 0x8c, 0xd9, 0xcc, 0x31, 0x54, 0x66, 0xcc, 0x66, 0xde, 0x4f, 0x04, 0xf7, 0x2b, 0x53, 0x05,
+	0x67, 0x73, 0x41, 0xf1, 0x73, 0x30, 0x45, 0x9e, 0xe4, 0xe7, 0x42, 0xce, 0x6c, 0x85, 0x9e, 0x7f,
+	0x3d, 0x47, 0x7f, 0x28, 0x51, 0x3d, 0x36, 0xa1, 0xa4, 0xec, 0xc0, 0xfb, 0x50, 0x93, 0x60, 0xb9,
+	0xd7, 0x22, 0xea, 0x81, 0x0f, 0xa1, 0x26, 0xb5, 0xc8, 0xdd, 0x8d, 0xf0, 0x9e, 0x5f, 0x2a, 0x1b,
+	0xf9, 0x1f, 0x8b, 0x80, 0xa8, 0xaa, 0x97, 0x43, 0xf3, 0x84, 0xe6, 0x84, 0x5d, 0x6c, 0x25, 0x70,
+	0x1f, 0x6a, 0xd3, 0xf9, 0x84, 0x2e, 0x4a, 0x89, 0xea, 0x81, 0x9f, 0xc2, 0x4e, 0xb2, 0x98, 0x0a,
+	0xb9, 0xa9, 0x15, 0x3e, 0xba, 0x89, 0x7a, 0x77, 0x31, 0x15, 0x44, 0x22, 0xbd, 0x1f, 0x08, 0x5a,
+	0xeb, 0xb5, 0x77, 0x70, 0x81, 0x03, 0x30, 0x25, 0x58, 0x38, 0x7a, 0xdb, 0xe8, 0x58, 0xa4, 0x7c,
+	0x6d, 0x7b, 0x83, 0x10, 0x2c, 0x65, 0x47, 0x8f, 0xb1, 0x6c, 0x22, 0xd6, 0x36, 0xa2, 0x2b, 0x36,
+	0xea, 0x1b, 0x1b, 0xe7, 0x15, 0x17, 0xc5, 0x56, 0xb7, 0x7b, 0x01, 0xe6, 0x58, 0xce, 0x97, 0x24,
+	0x1b, 0xe1, 0x93, 0x1b, 0x05, 0x56, 0xb8, 0x90, 0xb2, 0xc7, 0x7b, 0x0f, 0x0d, 0x95, 0x97, 0xcc,
+	0x37, 0x9e, 0xa3, 0x6b, 0x3d, 0xd7, 0x6f, 0xd5, 0xfb, 0x0b, 0x01, 0xae, 0x92, 0xbf, 0x03, 0x07,
+	0x5e, 0x42, 0x5d, 0x15, 0xd7, 0xea, 0x1e, 0xdf, 0xae, 0x4e, 0xf1, 0x59, 0xf7, 0x1c, 0xbd, 0x02,
+	0xd8, 0x0c, 0xc5, 0x0d, 0xa8, 0xf7, 0xa3, 0xcf, 0xdd, 0x41, 0xff, 0xd8, 0xd6, 0xb0, 0x09, 0x7a,
+	0xfc, 0xc1, 0x46, 0xb8, 0x09, 0x7b, 0x51, 0x7c, 0x7a, 0xf6, 0x36, 0xfe, 0x14, 0x1d, 0xdb, 0x3a,
+	0xb6, 0x60, 0xb7, 0x1f, 0x9d, 0xbe, 0x21, 0x51, 0x77, 0x60, 0x1b, 0x47, 0x0e, 0xec, 0x14, 0x5f,
+	0x17, 0xae, 0x83, 0x41, 0xe2, 0x2f, 0xb6, 0x56, 0x04, 0xbd, 0x78, 0x60, 0xa3, 0xd7, 0xce, 0x9f,
+	0xa5, 0x8b, 0x2e, 0x97, 0x2e, 0xfa, 0xb7, 0x74, 0xd1, 0xef, 0x95, 0xab, 0x5d, 0xae, 0x5c, 0xed,
+	0xef, 0xca, 0xd5, 0x46, 0xa6, 0xfc, 0xc9, 0x9f, 0xfd, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x53,
+	0x05, 0xeb, 0x35, 0x04, 0x00, 0x00,
+}
+
+func (m *GetSampleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSampleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSampleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Col != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Row != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintSample(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSampleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSampleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSampleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSample(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintSample(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Axis != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Axis))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintSample(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSample(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintSample(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SampleCoords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SampleCoords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SampleCoords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Col != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Row != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSamplesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSamplesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSamplesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coords) > 0 {
+		for iNdEx := len(m.Coords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSample(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintSample(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SampleProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SampleProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SampleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSample(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintSample(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSamplesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSamplesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSamplesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Samples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSample(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintSample(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSample(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSample(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetSampleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovSample(uint64(l))
+	}
+	if m.Row != 0 {
+		n += 1 + sovSample(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovSample(uint64(m.Col))
+	}
+	return n
+}
+
+func (m *GetSampleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovSample(uint64(m.Status))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovSample(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovSample(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovSample(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovSample(uint64(m.Index))
+	}
+	if m.Axis != 0 {
+		n += 1 + sovSample(uint64(m.Axis))
+	}
+	return n
+}
+
+func (m *GetRowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovSample(uint64(m.Status))
+	}
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovSample(uint64(l))
+		}
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovSample(uint64(l))
+	}
+	return n
+}
+
+func (m *SampleCoords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Row != 0 {
+		n += 1 + sovSample(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovSample(uint64(m.Col))
+	}
+	return n
+}
+
+func (m *GetSamplesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovSample(uint64(l))
+	}
+	if len(m.Coords) > 0 {
+		for _, e := range m.Coords {
+			l = e.Size()
+			n += 1 + l + sovSample(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SampleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovSample(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovSample(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSamplesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovSample(uint64(m.Status))
+	}
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovSample(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSample(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSample(x uint64) (n int) {
+	return sovSample(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetSampleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSampleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSampleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSampleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSampleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSampleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StatusCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &pb.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Axis", wireType)
+			}
+			m.Axis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Axis |= Axis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StatusCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, make([]byte, postIndex-iNdEx))
+			copy(m.Shares[len(m.Shares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &pb.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SampleCoords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SampleCoords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SampleCoords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSamplesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSamplesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSamplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coords = append(m.Coords, &SampleCoords{})
+			if err := m.Coords[len(m.Coords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SampleProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SampleProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SampleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &pb.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSamplesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSamplesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSamplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StatusCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSample
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSample
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, &SampleProof{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSample(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSample
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSample(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSample
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSample
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSample
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSample
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSample
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSample        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSample          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSample = fmt.Errorf("proto: unexpected end of group")
+)
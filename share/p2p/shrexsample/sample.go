@@ -0,0 +1,43 @@
+package shrexsample
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/nmt"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// Sample is a single share of the extended data square along with its inclusion proof against
+// the root of its row.
+type Sample struct {
+	Share share.Share
+	Proof *nmt.Proof
+}
+
+// Verify checks that the share is the one at the given coordinates of the square committed to by
+// the share.Root.
+func (s *Sample) Verify(root *share.Root, row, col int) error {
+	width := len(root.RowRoots)
+	if row < 0 || col < 0 || row >= width || col >= width {
+		return share.ErrOutOfBounds
+	}
+	if len(s.Share) != share.Size {
+		return fmt.Errorf("invalid share size: %d", len(s.Share))
+	}
+	if s.Proof == nil || s.Proof.Start() != col || s.Proof.End() != col+1 {
+		return errors.New("proof doesn't match the column of the share")
+	}
+
+	// the leaves outside the original data square are namespaced with the parity namespace
+	namespace := share.ParitySharesNamespace
+	if row < width/2 && col < width/2 {
+		namespace = share.GetNamespace(s.Share)
+	}
+	if !s.Proof.VerifyInclusion(sha256.New(), namespace.ToNMT(), [][]byte{s.Share}, root.RowRoots[row]) {
+		return errors.New("invalid inclusion proof")
+	}
+	return nil
+}
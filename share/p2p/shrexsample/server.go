@@ -0,0 +1,420 @@
+package shrexsample
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/boxo/blockservice"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"go.uber.org/zap"
+
+	"github.com/celestiaorg/go-libp2p-messenger/serde"
+	"github.com/celestiaorg/nmt"
+	nmt_pb "github.com/celestiaorg/nmt/pb"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexsample/pb"
+)
+
+// Server implements server side of shrex/sample, shrex/samples and shrex/row protocols to serve
+// single shares, batches of shares and halves of rows or columns of the extended data square to
+// remote peers.
+type Server struct {
+	cancel context.CancelFunc
+
+	host              host.Host
+	protocolID        protocol.ID
+	samplesProtocolID protocol.ID
+	rowProtocolID     protocol.ID
+
+	handler        network.StreamHandler
+	samplesHandler network.StreamHandler
+	rowHandler     network.StreamHandler
+	store          *eds.Store
+
+	params     *Parameters
+	middleware *p2p.Middleware
+	metrics    *p2p.Metrics
+}
+
+// NewServer creates new Server
+func NewServer(params *Parameters, host host.Host, store *eds.Store) (*Server, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("shrex-sample: server creation failed: %w", err)
+	}
+
+	srv := &Server{
+		store:             store,
+		host:              host,
+		params:            params,
+		protocolID:        p2p.ProtocolID(params.NetworkID(), protocolString),
+		samplesProtocolID: p2p.ProtocolID(params.NetworkID(), samplesProtocolString),
+		rowProtocolID:     p2p.ProtocolID(params.NetworkID(), rowProtocolString),
+		middleware:        p2p.NewMiddleware(params.ConcurrencyLimit),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv.cancel = cancel
+
+	srv.handler = srv.middleware.RateLimitHandler(srv.streamHandler(ctx, srv.handleSample))
+	srv.samplesHandler = srv.middleware.RateLimitHandler(srv.streamHandler(ctx, srv.handleSamples))
+	srv.rowHandler = srv.middleware.RateLimitHandler(srv.streamHandler(ctx, srv.handleRow))
+	return srv, nil
+}
+
+// Start starts the server
+func (srv *Server) Start(context.Context) error {
+	srv.host.SetStreamHandler(srv.protocolID, srv.handler)
+	srv.host.SetStreamHandler(srv.samplesProtocolID, srv.samplesHandler)
+	srv.host.SetStreamHandler(srv.rowProtocolID, srv.rowHandler)
+	return nil
+}
+
+// Stop stops the server
+func (srv *Server) Stop(context.Context) error {
+	srv.cancel()
+	srv.host.RemoveStreamHandler(srv.protocolID)
+	srv.host.RemoveStreamHandler(srv.samplesProtocolID)
+	srv.host.RemoveStreamHandler(srv.rowProtocolID)
+	return nil
+}
+
+func (srv *Server) streamHandler(
+	ctx context.Context,
+	handle func(context.Context, network.Stream) error,
+) network.StreamHandler {
+	return func(s network.Stream) {
+		err := handle(ctx, s)
+		if err != nil {
+			s.Reset() //nolint:errcheck
+			return
+		}
+		if err = s.Close(); err != nil {
+			log.Debugw("server: closing stream", "err", err)
+		}
+	}
+}
+
+func (srv *Server) observeRateLimitedRequests() {
+	numRateLimited := srv.middleware.DrainCounter()
+	if numRateLimited > 0 {
+		srv.metrics.ObserveRequests(context.Background(), numRateLimited, p2p.StatusRateLimited)
+	}
+}
+
+func (srv *Server) handleSample(ctx context.Context, stream network.Stream) error {
+	logger := log.With("source", "server", "peer", stream.Conn().RemotePeer().String())
+	logger.Debug("handling sample request")
+
+	srv.observeRateLimitedRequests()
+	var req pb.GetSampleRequest
+	err := srv.readRequest(logger, stream, &req, req.GetRootHash)
+	if err != nil {
+		logger.Warnw("read request", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
+		return err
+	}
+
+	logger = logger.With("hash", share.DataHash(req.RootHash).String(), "row", req.Row, "col", req.Col)
+
+	ctx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
+	defer cancel()
+
+	samples, status, err := srv.getSamples(ctx, req.RootHash, []share.Sample{{Row: int(req.Row), Col: int(req.Col)}})
+	if err != nil {
+		// server should respond with status regardless if there was an error getting data
+		logger.Errorw("handling request", "err", err)
+	}
+
+	resp := &pb.GetSampleResponse{Status: status}
+	if len(samples) != 0 {
+		resp.Share = samples[0].Share
+		resp.Proof = proofToProto(samples[0].Proof)
+	}
+	err = srv.respond(ctx, logger, stream, status, resp)
+	if err != nil {
+		logger.Errorw("sending response", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSendRespErr)
+		return err
+	}
+	return nil
+}
+
+func (srv *Server) handleSamples(ctx context.Context, stream network.Stream) error {
+	logger := log.With("source", "server", "peer", stream.Conn().RemotePeer().String())
+	logger.Debug("handling samples request")
+
+	srv.observeRateLimitedRequests()
+	var req pb.GetSamplesRequest
+	err := srv.readRequest(logger, stream, &req, req.GetRootHash)
+	if err == nil && (len(req.Coords) == 0 || len(req.Coords) > MaxSamplesPerRequest) {
+		err = fmt.Errorf("invalid request: amount of samples must be in range [1, %d], got %d",
+			MaxSamplesPerRequest, len(req.Coords))
+	}
+	if err != nil {
+		logger.Warnw("read request", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
+		return err
+	}
+
+	logger = logger.With("hash", share.DataHash(req.RootHash).String(), "amount", len(req.Coords))
+
+	ctx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
+	defer cancel()
+
+	coords := make([]share.Sample, len(req.Coords))
+	for i, c := range req.Coords {
+		coords[i] = share.Sample{Row: int(c.Row), Col: int(c.Col)}
+	}
+	samples, status, err := srv.getSamples(ctx, req.RootHash, coords)
+	if err != nil {
+		// server should respond with status regardless if there was an error getting data
+		logger.Errorw("handling request", "err", err)
+	}
+
+	resp := &pb.GetSamplesResponse{Status: status}
+	for _, smpl := range samples {
+		resp.Samples = append(resp.Samples, &pb.SampleProof{
+			Share: smpl.Share,
+			Proof: proofToProto(smpl.Proof),
+		})
+	}
+	err = srv.respond(ctx, logger, stream, status, resp)
+	if err != nil {
+		logger.Errorw("sending response", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSendRespErr)
+		return err
+	}
+	return nil
+}
+
+func (srv *Server) handleRow(ctx context.Context, stream network.Stream) error {
+	logger := log.With("source", "server", "peer", stream.Conn().RemotePeer().String())
+	logger.Debug("handling row request")
+
+	srv.observeRateLimitedRequests()
+	var req pb.GetRowRequest
+	err := srv.readRequest(logger, stream, &req, req.GetRootHash)
+	if err == nil && req.Axis != pb.Axis_ROW && req.Axis != pb.Axis_COL {
+		err = fmt.Errorf("invalid request: unknown axis: %d", req.Axis)
+	}
+	if err != nil {
+		logger.Warnw("read request", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
+		return err
+	}
+
+	axis := rsmt2d.Axis(req.Axis)
+	logger = logger.With("hash", share.DataHash(req.RootHash).String(), "index", req.Index, "axis", axis.String())
+
+	ctx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
+	defer cancel()
+
+	half, status, err := srv.getRow(ctx, req.RootHash, int(req.Index), axis)
+	if err != nil {
+		// server should respond with status regardless if there was an error getting data
+		logger.Errorw("handling request", "err", err)
+	}
+
+	resp := &pb.GetRowResponse{Status: status}
+	if half != nil {
+		resp.Shares = half.Shares
+		resp.Proof = proofToProto(half.Proof)
+	}
+	err = srv.respond(ctx, logger, stream, status, resp)
+	if err != nil {
+		logger.Errorw("sending response", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSendRespErr)
+		return err
+	}
+	return nil
+}
+
+// readRequest reads the request from the stream and validates its root hash.
+func (srv *Server) readRequest(
+	logger *zap.SugaredLogger,
+	stream network.Stream,
+	req serde.Message,
+	rootHash func() []byte,
+) error {
+	err := stream.SetReadDeadline(time.Now().Add(srv.params.ServerReadTimeout))
+	if err != nil {
+		logger.Debugw("setting read deadline", "err", err)
+	}
+
+	_, err = serde.Read(stream, req)
+	if err != nil {
+		return fmt.Errorf("reading request: %w", err)
+	}
+
+	logger.Debugw("new request")
+	err = stream.CloseRead()
+	if err != nil {
+		logger.Debugw("closing read side of the stream", "err", err)
+	}
+
+	if len(rootHash()) != sha256.Size {
+		return fmt.Errorf("invalid request: incorrect root hash length: %v", len(rootHash()))
+	}
+	return nil
+}
+
+// getSamples retrieves the shares at the given coordinates of the square with the given hash from
+// the store, along with their proofs against the roots of their rows, and reports the status to
+// respond with. The CAR blockstore of the square is opened once for all the samples.
+func (srv *Server) getSamples(
+	ctx context.Context,
+	hash share.DataHash,
+	samples []share.Sample,
+) ([]*Sample, pb.StatusCode, error) {
+	dah, err := srv.store.GetDAH(ctx, hash)
+	if err != nil {
+		if errors.Is(err, eds.ErrNotFound) {
+			return nil, pb.StatusCode_NOT_FOUND, nil
+		}
+		return nil, pb.StatusCode_INTERNAL, fmt.Errorf("retrieving DAH: %w", err)
+	}
+
+	width := len(dah.RowRoots)
+	if err := share.ValidateSamples(samples, width); err != nil {
+		return nil, pb.StatusCode_INVALID, fmt.Errorf("coordinates out of square of width %d", width)
+	}
+
+	bs, err := srv.store.CARBlockstore(ctx, hash)
+	if err != nil {
+		if errors.Is(err, eds.ErrNotFound) {
+			return nil, pb.StatusCode_NOT_FOUND, nil
+		}
+		return nil, pb.StatusCode_INTERNAL, fmt.Errorf("retrieving blockstore: %w", err)
+	}
+	defer func() {
+		if err := bs.Close(); err != nil {
+			log.Warnw("closing blockstore", "err", err)
+		}
+	}()
+
+	// wrap the read-only CAR blockstore in a getter
+	blockGetter := eds.NewBlockGetter(bs)
+	proved := make([]*Sample, len(samples))
+	for i, smpl := range samples {
+		proved[i], err = proveSample(ctx, blockGetter, dah, smpl.Row, smpl.Col)
+		if err != nil {
+			return nil, pb.StatusCode_INTERNAL, err
+		}
+	}
+	return proved, pb.StatusCode_OK, nil
+}
+
+// proveSample retrieves the share at the given coordinates along with its proof against the root
+// of its row.
+func proveSample(
+	ctx context.Context,
+	blockGetter blockservice.BlockGetter,
+	dah *share.Root,
+	row, col int,
+) (*Sample, error) {
+	width := len(dah.RowRoots)
+	root := ipld.MustCidFromNamespacedSha256(dah.RowRoots[row])
+	leaf, err := ipld.GetLeaf(ctx, blockGetter, root, col, width)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving share: %w", err)
+	}
+	path, err := ipld.GetProof(ctx, blockGetter, root, nil, col, width)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving proof: %w", err)
+	}
+
+	// the proof expects the nodes in the reverse order of the collected path
+	nodes := make([][]byte, 0, len(path))
+	for i := len(path) - 1; i >= 0; i-- {
+		nodes = append(nodes, ipld.NamespacedSha256FromCID(path[i]))
+	}
+	proof := nmt.NewInclusionProof(col, col+1, nodes, true)
+	return &Sample{
+		// the leaf is prefixed by its namespace in the row tree
+		Share: leaf.RawData()[share.NamespaceSize:],
+		Proof: &proof,
+	}, nil
+}
+
+// getRow retrieves the first half of the requested row or column from the store along with its
+// proof against the root of the axis.
+func (srv *Server) getRow(
+	ctx context.Context,
+	hash share.DataHash,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisHalf, pb.StatusCode, error) {
+	dah, err := srv.store.GetDAH(ctx, hash)
+	if err != nil {
+		if errors.Is(err, eds.ErrNotFound) {
+			return nil, pb.StatusCode_NOT_FOUND, nil
+		}
+		return nil, pb.StatusCode_INTERNAL, fmt.Errorf("retrieving DAH: %w", err)
+	}
+
+	half, err := eds.RetrieveAxisHalfFromStore(ctx, srv.store, dah, idx, axis)
+	switch {
+	case err == nil:
+		return half, pb.StatusCode_OK, nil
+	case errors.Is(err, share.ErrOutOfBounds):
+		return nil, pb.StatusCode_INVALID, err
+	case errors.Is(err, share.ErrNotFound):
+		return nil, pb.StatusCode_NOT_FOUND, nil
+	default:
+		return nil, pb.StatusCode_INTERNAL, err
+	}
+}
+
+func (srv *Server) respond(
+	ctx context.Context,
+	logger *zap.SugaredLogger,
+	stream network.Stream,
+	status pb.StatusCode,
+	resp serde.Message,
+) error {
+	srv.observeStatus(ctx, status)
+
+	err := stream.SetWriteDeadline(time.Now().Add(srv.params.ServerWriteTimeout))
+	if err != nil {
+		logger.Debugw("setting write deadline", "err", err)
+	}
+
+	_, err = serde.Write(stream, resp)
+	if err != nil {
+		return fmt.Errorf("writing response: %w", err)
+	}
+	return nil
+}
+
+func (srv *Server) observeStatus(ctx context.Context, status pb.StatusCode) {
+	switch {
+	case status == pb.StatusCode_OK:
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSuccess)
+	case status == pb.StatusCode_NOT_FOUND:
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusNotFound)
+	case status == pb.StatusCode_INTERNAL:
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusInternalErr)
+	case status == pb.StatusCode_INVALID:
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
+	}
+}
+
+// proofToProto converts the inclusion proof into its proto representation.
+func proofToProto(proof *nmt.Proof) *nmt_pb.Proof {
+	return &nmt_pb.Proof{
+		Start:                 int64(proof.Start()),
+		End:                   int64(proof.End()),
+		Nodes:                 proof.Nodes(),
+		IsMaxNamespaceIgnored: proof.IsMaxNamespaceIDIgnored(),
+	}
+}
@@ -0,0 +1,17 @@
+package share
+
+// Sample is a point in 2D space over the extended data square.
+type Sample struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// ValidateSamples checks that all the samples are within the square of the given width.
+func ValidateSamples(samples []Sample, width int) error {
+	for _, s := range samples {
+		if s.Row < 0 || s.Col < 0 || s.Row >= width || s.Col >= width {
+			return ErrOutOfBounds
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+var ErrEmptyAccountName = errors.New("state: account name must not be empty")
+
+// Account is an account of the node's keyring that can be used to sign transactions.
+type Account struct {
+	Name    string  `json:"name"`
+	Address Address `json:"address"`
+	// Mnemonic is only set once the account is created by the node and is never stored or
+	// returned again.
+	Mnemonic string `json:"mnemonic,omitempty"`
+}
+
+// ListAccounts returns all the accounts of the node's keyring.
+func (ca *CoreAccessor) ListAccounts(context.Context) ([]*Account, error) {
+	records, err := ca.signer.List()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*Account, 0, len(records))
+	for _, record := range records {
+		account, err := newAccount(record, "")
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// AddAccount generates a new key with the given name in the node's keyring. The mnemonic of the
+// key is returned within the Account only once.
+func (ca *CoreAccessor) AddAccount(_ context.Context, name string) (*Account, error) {
+	if name == "" {
+		return nil, ErrEmptyAccountName
+	}
+
+	record, mnemonic, err := ca.signer.NewMnemonic(name, keyring.English, sdktypes.GetConfig().GetFullBIP44Path(),
+		keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	if err != nil {
+		return nil, err
+	}
+	log.Infow("added account to keyring", "name", name)
+	return newAccount(record, mnemonic)
+}
+
+// ImportAccount restores the key with the given name in the node's keyring from the mnemonic.
+func (ca *CoreAccessor) ImportAccount(_ context.Context, name, mnemonic string) (*Account, error) {
+	if name == "" {
+		return nil, ErrEmptyAccountName
+	}
+
+	record, err := ca.signer.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase,
+		sdktypes.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+	if err != nil {
+		return nil, err
+	}
+	log.Infow("imported account to keyring", "name", name)
+	return newAccount(record, "")
+}
+
+// accountSigner returns the signer of the account with the given key name or bech32 address. The
+// default signer of the node is returned if the account is empty.
+func (ca *CoreAccessor) accountSigner(account string) (*accountSigner, error) {
+	defaultName := ca.signer.GetSignerInfo().Name
+	name := defaultName
+	if account != "" {
+		record, err := ca.keyRecord(account)
+		if err != nil {
+			return nil, err
+		}
+		name = record.Name
+	}
+
+	ca.signersLock.Lock()
+	defer ca.signersLock.Unlock()
+	if signer, ok := ca.signers[name]; ok {
+		return signer, nil
+	}
+
+	signer := ca.signer
+	if name != defaultName {
+		signerData, err := ca.signer.GetSignerData()
+		if err != nil {
+			return nil, err
+		}
+		signer = apptypes.NewKeyringSigner(ca.signer.Keyring, name, signerData.ChainID)
+	}
+
+	accSigner := &accountSigner{KeyringSigner: signer}
+	ca.signers[name] = accSigner
+	return accSigner, nil
+}
+
+// keyRecord finds the keyring record of the account by its key name or bech32 address.
+func (ca *CoreAccessor) keyRecord(account string) (*keyring.Record, error) {
+	if addr, err := sdktypes.AccAddressFromBech32(account); err == nil {
+		record, err := ca.signer.KeyByAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("state: finding key by address %s: %w", account, err)
+		}
+		return record, nil
+	}
+
+	record, err := ca.signer.Key(account)
+	if err != nil {
+		return nil, fmt.Errorf("state: finding key by name %s: %w", account, err)
+	}
+	return record, nil
+}
+
+func newAccount(record *keyring.Record, mnemonic string) (*Account, error) {
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		Name:     record.Name,
+		Address:  Address{addr},
+		Mnemonic: mnemonic,
+	}, nil
+}
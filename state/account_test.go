@@ -0,0 +1,79 @@
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+func TestAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	ring := keyring.NewInMemory(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec)
+	_, _, err := ring.NewMnemonic("default", keyring.English, sdktypes.GetConfig().GetFullBIP44Path(),
+		keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	ca := NewCoreAccessor(apptypes.NewKeyringSigner(ring, "default", "private"), nil, "", "", "")
+
+	added, err := ca.AddAccount(ctx, "added")
+	require.NoError(t, err)
+	require.Equal(t, "added", added.Name)
+	require.NotEmpty(t, added.Mnemonic)
+
+	_, err = ca.AddAccount(ctx, "")
+	require.ErrorIs(t, err, ErrEmptyAccountName)
+
+	_, err = ca.ImportAccount(ctx, "duplicate", added.Mnemonic)
+	require.Error(t, err)
+
+	// the mnemonic restores the address it was generated for
+	otherRing := keyring.NewInMemory(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec)
+	record, mnemonic, err := otherRing.NewMnemonic("other", keyring.English,
+		sdktypes.GetConfig().GetFullBIP44Path(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	imported, err := ca.ImportAccount(ctx, "imported", mnemonic)
+	require.NoError(t, err)
+	require.Empty(t, imported.Mnemonic)
+	require.Equal(t, addr.String(), imported.Address.String())
+
+	accounts, err := ca.ListAccounts(ctx)
+	require.NoError(t, err)
+	names := make([]string, 0, len(accounts))
+	for _, acc := range accounts {
+		require.Empty(t, acc.Mnemonic)
+		names = append(names, acc.Name)
+	}
+	require.ElementsMatch(t, []string{"default", "added", "imported"}, names)
+
+	defaultSigner, err := ca.accountSigner("")
+	require.NoError(t, err)
+	require.Equal(t, ca.signer, defaultSigner.KeyringSigner)
+	byName, err := ca.accountSigner("default")
+	require.NoError(t, err)
+	require.Same(t, defaultSigner, byName)
+
+	addedSigner, err := ca.accountSigner("added")
+	require.NoError(t, err)
+	require.Equal(t, "added", addedSigner.GetSignerInfo().Name)
+	signerData, err := addedSigner.GetSignerData()
+	require.NoError(t, err)
+	require.Equal(t, "private", signerData.ChainID)
+
+	importedSigner, err := ca.accountSigner(imported.Address.String())
+	require.NoError(t, err)
+	require.Equal(t, "imported", importedSigner.GetSignerInfo().Name)
+
+	_, err = ca.accountSigner("unknown")
+	require.Error(t, err)
+}
@@ -1,10 +1,12 @@
 package state
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"math"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,20 +16,21 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
-	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/client/http"
+	coretypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/celestiaorg/celestia-app/app"
 	apperrors "github.com/celestiaorg/celestia-app/app/errors"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
-	appblob "github.com/celestiaorg/celestia-app/x/blob"
 	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	libhead "github.com/celestiaorg/go-header"
 
@@ -38,9 +41,24 @@ import (
 var (
 	log              = logging.Logger("state")
 	ErrInvalidAmount = errors.New("state: amount must be greater than zero")
+	ErrNoBlobs       = errors.New("state: no blobs provided")
 )
 
-const maxRetries = 5
+const (
+	maxRetries = 5
+	// txPollInterval is the interval between checks of whether a submitted transaction is committed.
+	txPollInterval = time.Second
+	// txRebroadcastBackoff is the initial interval between rebroadcasts of a submitted transaction
+	// that was evicted from the mempool. It doubles with every rebroadcast up to
+	// txMaxRebroadcastBackoff.
+	txRebroadcastBackoff    = 2 * time.Second
+	txMaxRebroadcastBackoff = time.Minute
+	// maxUnconfirmedTxs is the maximum number of transactions the mempool lists at once.
+	maxUnconfirmedTxs = 100
+	// pendingPFBTimeout is the time an asynchronously submitted PayForBlob is tracked for, after
+	// which it is no longer counted as successful once included.
+	pendingPFBTimeout = time.Hour
+)
 
 // CoreAccessor implements service over a gRPC connection
 // with a celestia-core node.
@@ -48,12 +66,17 @@ type CoreAccessor struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	// signer is the default signer of the node
 	signer *apptypes.KeyringSigner
 	getter libhead.Head[*header.ExtendedHeader]
 
+	signersLock sync.Mutex
+	// signers holds signers of all the keyring accounts used to sign transactions, by key name
+	signers map[string]*accountSigner
+
 	queryCli   banktypes.QueryClient
 	stakingCli stakingtypes.QueryClient
-	rpcCli     rpcclient.ABCIClient
+	rpcCli     rpcclient.Client
 
 	prt *merkle.ProofRuntime
 
@@ -62,10 +85,17 @@ type CoreAccessor struct {
 	rpcPort  string
 	grpcPort string
 
+	// txCommitTimeout bounds the time to wait for a submitted transaction to be committed on top
+	// of the caller's context. Zero means no bound.
+	txCommitTimeout time.Duration
+
 	// these fields are mutatable and thus need to be protected by a mutex
 	lock            sync.Mutex
 	lastPayForBlob  int64
 	payForBlobCount int64
+	// pendingPFBs holds the submission times of the asynchronously submitted PayForBlobs, by hash,
+	// until they are reported as committed by TxStatus.
+	pendingPFBs map[string]time.Time
 	// minGasPrice is the minimum gas price that the node will accept.
 	// NOTE: just because the first node accepts the transaction, does not mean it
 	// will find a proposer that does accept the transaction. Better would be
@@ -73,6 +103,17 @@ type CoreAccessor struct {
 	minGasPrice float64
 }
 
+// Option configures the CoreAccessor.
+type Option func(*CoreAccessor)
+
+// WithTxCommitTimeout bounds the time to wait for a submitted transaction to be committed. The
+// wait is bound only by the caller's context if the timeout is zero.
+func WithTxCommitTimeout(timeout time.Duration) Option {
+	return func(ca *CoreAccessor) {
+		ca.txCommitTimeout = timeout
+	}
+}
+
 // NewCoreAccessor dials the given celestia-core endpoint and
 // constructs and returns a new CoreAccessor (state service) with the active
 // connection.
@@ -82,19 +123,27 @@ func NewCoreAccessor(
 	coreIP,
 	rpcPort string,
 	grpcPort string,
+	opts ...Option,
 ) *CoreAccessor {
 	// create verifier
 	prt := merkle.DefaultProofRuntime()
 	prt.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
-	return &CoreAccessor{
+	ca := &CoreAccessor{
 		signer:   signer,
 		getter:   getter,
 		coreIP:   coreIP,
 		rpcPort:  rpcPort,
 		grpcPort: grpcPort,
 		prt:      prt,
+		signers:  make(map[string]*accountSigner),
+
+		pendingPFBs: make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(ca)
 	}
+	return ca
 }
 
 func (ca *CoreAccessor) Start(ctx context.Context) error {
@@ -163,21 +212,177 @@ func (ca *CoreAccessor) cancelCtx() {
 }
 
 func (ca *CoreAccessor) constructSignedTx(
-	ctx context.Context,
+	signer *apptypes.KeyringSigner,
 	msg sdktypes.Msg,
 	opts ...apptypes.TxBuilderOption,
 ) ([]byte, error) {
-	// should be called first in order to make a valid tx
-	err := ca.signer.QueryAccountNumber(ctx, ca.coreConn)
+	tx, err := signer.BuildSignedTx(signer.NewTxBuilder(opts...), msg)
 	if err != nil {
 		return nil, err
 	}
+	return signer.EncodeTx(tx)
+}
 
-	tx, err := ca.signer.BuildSignedTx(ca.signer.NewTxBuilder(opts...), msg)
+// constructPayForBlobTx builds and signs a MsgPayForBlobs for the given blobs and wraps it
+// together with the blobs into a BlobTx.
+func (ca *CoreAccessor) constructPayForBlobTx(
+	signer *apptypes.KeyringSigner,
+	blobs []*apptypes.Blob,
+	opts ...apptypes.TxBuilderOption,
+) ([]byte, error) {
+	addr, err := signer.GetSignerInfo().GetAddress()
 	if err != nil {
 		return nil, err
 	}
-	return ca.signer.EncodeTx(tx)
+	msg, err := apptypes.NewMsgPayForBlobs(addr.String(), blobs...)
+	if err != nil {
+		return nil, err
+	}
+	rawTx, err := ca.constructSignedTx(signer, msg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return coretypes.MarshalBlobTx(rawTx, blobs...)
+}
+
+// submitMsg signs the message built for the address of the given account with the account's key
+// and submits it. The default account of the node is used if the account is empty.
+func (ca *CoreAccessor) submitMsg(
+	ctx context.Context,
+	account string,
+	buildMsg func(from AccAddress) sdktypes.Msg,
+	fee Int,
+	gasLim uint64,
+) (*TxResponse, error) {
+	signer, err := ca.accountSigner(account)
+	if err != nil {
+		return nil, err
+	}
+	from, err := signer.GetSignerInfo().GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := buildMsg(from)
+	return ca.submitTx(ctx, signer, sdktx.BroadcastMode_BROADCAST_MODE_BLOCK,
+		func(signer *apptypes.KeyringSigner) ([]byte, error) {
+			return ca.constructSignedTx(signer, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
+		})
+}
+
+// submitTx signs the transaction built by the given function with the next sequence of the signer
+// and broadcasts it. Only the signing and the admission to the mempool are serialized per signer,
+// so concurrent transactions of the same account are pipelined. In the block mode, it waits until
+// the transaction is committed, which is equivalent to broadcasting it with the block mode, except
+// that the admission of the following transactions is not held back and the wait is bound by the
+// context rather than by the broadcast timeout of the core node.
+func (ca *CoreAccessor) submitTx(
+	ctx context.Context,
+	signer *accountSigner,
+	mode sdktx.BroadcastMode,
+	buildTx func(*apptypes.KeyringSigner) ([]byte, error),
+) (*TxResponse, error) {
+	resp, tx, err := signer.broadcast(ctx, ca.coreConn, buildTx)
+	if err != nil || resp.Code != 0 || mode != sdktx.BroadcastMode_BROADCAST_MODE_BLOCK {
+		return resp, err
+	}
+	return ca.waitForTx(ctx, resp.TxHash, tx)
+}
+
+// waitForTx polls the status of the transaction with the given hash until it is committed or the
+// context is done. The transaction is broadcasted again only once it is missing from the mempool
+// without being committed, with the interval between the accepted rebroadcasts growing
+// exponentially.
+func (ca *CoreAccessor) waitForTx(ctx context.Context, txHash string, tx *signedTx) (*TxResponse, error) {
+	if ca.txCommitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ca.txCommitTimeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
+	backoff := txRebroadcastBackoff
+	var nextRebroadcast time.Time
+	for {
+		resp, err := ca.TxStatus(ctx, txHash)
+		if err != nil || resp != nil {
+			return resp, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for transaction %s to be committed: %w", txHash, ctx.Err())
+		case <-ticker.C:
+		}
+
+		if time.Now().Before(nextRebroadcast) {
+			continue
+		}
+		// the mempool rechecks pending transactions concurrently after each block, so the
+		// transaction can be evicted when it is rechecked before the preceding transaction of the
+		// same account.
+		evicted, err := ca.isEvicted(ctx, tx.raw)
+		if err != nil {
+			return nil, err
+		}
+		if !evicted {
+			continue
+		}
+
+		log.Debugw("rebroadcasting evicted transaction", "hash", txHash)
+		rebroadcast, err := apptypes.BroadcastTx(ctx, ca.coreConn, sdktx.BroadcastMode_BROADCAST_MODE_SYNC, tx.raw)
+		if err != nil {
+			return nil, err
+		}
+		txResp := rebroadcast.TxResponse
+		if txResp.Code == 0 {
+			nextRebroadcast = time.Now().Add(backoff)
+			backoff = min(backoff*2, txMaxRebroadcastBackoff)
+			continue
+		}
+
+		err = sdkErrors.ABCIError(txResp.Codespace, txResp.Code, txResp.RawLog)
+		if apperrors.IsNonceMismatch(err) {
+			expected, err := apperrors.ParseNonceMismatch(err)
+			if err == nil && expected < tx.sequence {
+				// the preceding transactions of the account are evicted as well and are about to be
+				// broadcasted again, so the transaction is retried on the next poll
+				continue
+			}
+		}
+		// the transaction can't enter the mempool anymore, unless it was committed meanwhile
+		resp, err = ca.TxStatus(ctx, txHash)
+		if err != nil || resp != nil {
+			return resp, err
+		}
+		return txResp, nil
+	}
+}
+
+// isEvicted reports whether the transaction is neither committed nor in the mempool. The eviction
+// cannot be confirmed while the mempool holds more transactions than it lists at once, in which
+// case the transaction is considered pending.
+func (ca *CoreAccessor) isEvicted(ctx context.Context, rawTx []byte) (bool, error) {
+	limit := maxUnconfirmedTxs
+	res, err := ca.rpcCli.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return false, fmt.Errorf("listing unconfirmed transactions: %w", err)
+	}
+	if res.Total > len(res.Txs) {
+		return false, nil
+	}
+
+	hash := coretypes.Tx(rawTx).Hash()
+	for _, tx := range res.Txs {
+		if bytes.Equal(tx.Hash(), hash) {
+			return false, nil
+		}
+	}
+	// the transaction could have been committed after its status was checked
+	resp, err := ca.TxStatus(ctx, hex.EncodeToString(hash))
+	return resp == nil, err
 }
 
 // SubmitPayForBlob builds, signs, and synchronously submits a MsgPayForBlob. It blocks until the
@@ -189,9 +394,70 @@ func (ca *CoreAccessor) SubmitPayForBlob(
 	fee Int,
 	gasLim uint64,
 	blobs []*blob.Blob,
+) (*TxResponse, error) {
+	return ca.SubmitPayForBlobWithAccount(ctx, fee, gasLim, blobs, "")
+}
+
+// SubmitPayForBlobWithAccount is SubmitPayForBlob signed by the given account of the node's
+// keyring, which is either a key name or a bech32 address of a key, or by the default account if it
+// is empty.
+func (ca *CoreAccessor) SubmitPayForBlobWithAccount(
+	ctx context.Context,
+	fee Int,
+	gasLim uint64,
+	blobs []*blob.Blob,
+	account string,
+) (*TxResponse, error) {
+	return ca.submitPayForBlob(ctx, fee, gasLim, blobs, account, sdktx.BroadcastMode_BROADCAST_MODE_BLOCK)
+}
+
+// SubmitPayForBlobAsync builds, signs, and submits a MsgPayForBlob without waiting for the
+// transaction to be committed. It returns once the transaction is accepted into the mempool, so
+// the returned TxResponse only contains the hash of the transaction, which can be used to track
+// its status with TxStatus. Gas limit, fee and account are handled the same way as in
+// SubmitPayForBlobWithAccount.
+func (ca *CoreAccessor) SubmitPayForBlobAsync(
+	ctx context.Context,
+	fee Int,
+	gasLim uint64,
+	blobs []*blob.Blob,
+	account string,
+) (*TxResponse, error) {
+	return ca.submitPayForBlob(ctx, fee, gasLim, blobs, account, sdktx.BroadcastMode_BROADCAST_MODE_SYNC)
+}
+
+// TxStatus returns the TxResponse of the committed transaction with the given hash. It returns
+// nil if the transaction is not committed yet. Asynchronously submitted PayForBlobs are counted as
+// successful once they are reported as committed.
+func (ca *CoreAccessor) TxStatus(ctx context.Context, txHash string) (*TxResponse, error) {
+	resp, err := sdktx.NewServiceClient(ca.coreConn).GetTx(ctx, &sdktx.GetTxRequest{Hash: txHash})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if ca.commitPendingPFB(txHash) && resp.TxResponse.Code == 0 {
+		ca.markSuccessfulPFB()
+	}
+	return resp.TxResponse, nil
+}
+
+func (ca *CoreAccessor) submitPayForBlob(
+	ctx context.Context,
+	fee Int,
+	gasLim uint64,
+	blobs []*blob.Blob,
+	account string,
+	mode sdktx.BroadcastMode,
 ) (*TxResponse, error) {
 	if len(blobs) == 0 {
-		return nil, errors.New("state: no blobs provided")
+		return nil, ErrNoBlobs
+	}
+
+	signer, err := ca.accountSigner(account)
+	if err != nil {
+		return nil, err
 	}
 
 	appblobs := make([]*apptypes.Blob, len(blobs))
@@ -205,15 +471,7 @@ func (ca *CoreAccessor) SubmitPayForBlob(
 	// we only estimate gas if the user wants us to (by setting the gasLim to 0). In the future we may
 	// want to make these arguments optional.
 	if gasLim == 0 {
-		blobSizes := make([]uint32, len(blobs))
-		for i, blob := range blobs {
-			blobSizes[i] = uint32(len(blob.Data))
-		}
-
-		// TODO (@cmwaters): the default gas per byte and the default tx size cost per byte could be changed
-		// through governance. This section could be more robust by tracking these values and adjusting the
-		// gas limit accordingly (as is done for the gas price)
-		gasLim = apptypes.EstimateGas(blobSizes, appconsts.DefaultGasPerBlobByte, auth.DefaultTxSizeCostPerByte)
+		gasLim = estimateGas(blobs)
 	}
 
 	minGasPrice := ca.getMinGasPrice()
@@ -222,43 +480,41 @@ func (ca *CoreAccessor) SubmitPayForBlob(
 	estimatedFee := false
 	if fee.IsNegative() {
 		estimatedFee = true
-		fee = sdktypes.NewInt(int64(math.Ceil(minGasPrice * float64(gasLim))))
+		fee = calculateFee(minGasPrice, gasLim)
 	}
 
 	var lastErr error
 	for attempt := 0; attempt < maxRetries; attempt++ {
-		response, err := appblob.SubmitPayForBlob(
-			ctx,
-			ca.signer,
-			ca.coreConn,
-			sdktx.BroadcastMode_BROADCAST_MODE_BLOCK,
-			appblobs,
-			apptypes.SetGasLimit(gasLim),
-			withFee(fee),
-		)
+		response, err := ca.submitTx(ctx, signer, mode, func(signer *apptypes.KeyringSigner) ([]byte, error) {
+			return ca.constructPayForBlobTx(signer, appblobs, apptypes.SetGasLimit(gasLim), withFee(fee))
+		})
+		if response != nil && response.Code != 0 {
+			err = sdkErrors.ABCIError(response.Codespace, response.Code, response.RawLog)
+		}
 
 		// the node is capable of changing the min gas price at any time so we must be able to detect it and
 		// update our version accordingly
 		if apperrors.IsInsufficientMinGasPrice(err) && estimatedFee {
+			lastErr = err
 			// The error message contains enough information to parse the new min gas price
 			minGasPrice, err = apperrors.ParseInsufficientMinGasPrice(err, minGasPrice, gasLim)
 			if err != nil {
 				return nil, fmt.Errorf("parsing insufficient min gas price error: %w", err)
 			}
 			ca.setMinGasPrice(minGasPrice)
-			lastErr = err
 			// update the fee to retry again
-			fee = sdktypes.NewInt(int64(math.Ceil(minGasPrice * float64(gasLim))))
+			fee = calculateFee(minGasPrice, gasLim)
 			continue
 		}
 
-		// metrics should only be counted on a successful PFD tx
-		if err == nil && response.Code == 0 {
+		// metrics should only be counted on a successful PFD tx, which is only known for the
+		// asynchronous submissions once the tx is committed
+		switch {
+		case err != nil:
+		case mode == sdktx.BroadcastMode_BROADCAST_MODE_BLOCK:
 			ca.markSuccessfulPFB()
-		}
-
-		if response != nil && response.Code != 0 {
-			err = errors.Join(err, sdkErrors.ABCIError(response.Codespace, response.Code, response.Logs.String()))
+		default:
+			ca.addPendingPFB(response.TxHash)
 		}
 		return response, err
 	}
@@ -367,22 +623,28 @@ func (ca *CoreAccessor) Transfer(
 	amount,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.TransferWithAccount(ctx, addr, amount, fee, gasLim, "")
+}
+
+// TransferWithAccount is Transfer signed by the given account of the node's keyring, which is
+// either a key name or a bech32 address of a key, or by the default account if it is empty.
+func (ca *CoreAccessor) TransferWithAccount(
+	ctx context.Context,
+	addr AccAddress,
+	amount,
+	fee Int,
+	gasLim uint64,
+	account string,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
-	if err != nil {
-		return nil, err
-	}
 	coins := sdktypes.NewCoins(sdktypes.NewCoin(app.BondDenom, amount))
-	msg := banktypes.NewMsgSend(from, addr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
-	if err != nil {
-		return nil, err
-	}
-	return ca.SubmitTx(ctx, signedTx)
+	return ca.submitMsg(ctx, account, func(from AccAddress) sdktypes.Msg {
+		return banktypes.NewMsgSend(from, addr, coins)
+	}, fee, gasLim)
 }
 
 func (ca *CoreAccessor) CancelUnbondingDelegation(
@@ -392,22 +654,30 @@ func (ca *CoreAccessor) CancelUnbondingDelegation(
 	height,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.CancelUnbondingDelegationWithAccount(ctx, valAddr, amount, height, fee, gasLim, "")
+}
+
+// CancelUnbondingDelegationWithAccount is CancelUnbondingDelegation signed by the given account of
+// the node's keyring, which is either a key name or a bech32 address of a key, or by the default
+// account if it is empty.
+func (ca *CoreAccessor) CancelUnbondingDelegationWithAccount(
+	ctx context.Context,
+	valAddr ValAddress,
+	amount,
+	height,
+	fee Int,
+	gasLim uint64,
+	account string,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
-	if err != nil {
-		return nil, err
-	}
 	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgCancelUnbondingDelegation(from, valAddr, height.Int64(), coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
-	if err != nil {
-		return nil, err
-	}
-	return ca.SubmitTx(ctx, signedTx)
+	return ca.submitMsg(ctx, account, func(from AccAddress) sdktypes.Msg {
+		return stakingtypes.NewMsgCancelUnbondingDelegation(from, valAddr, height.Int64(), coins)
+	}, fee, gasLim)
 }
 
 func (ca *CoreAccessor) BeginRedelegate(
@@ -417,22 +687,30 @@ func (ca *CoreAccessor) BeginRedelegate(
 	amount,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.BeginRedelegateWithAccount(ctx, srcValAddr, dstValAddr, amount, fee, gasLim, "")
+}
+
+// BeginRedelegateWithAccount is BeginRedelegate signed by the given account of the node's keyring,
+// which is either a key name or a bech32 address of a key, or by the default account if it is
+// empty.
+func (ca *CoreAccessor) BeginRedelegateWithAccount(
+	ctx context.Context,
+	srcValAddr,
+	dstValAddr ValAddress,
+	amount,
+	fee Int,
+	gasLim uint64,
+	account string,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
-	if err != nil {
-		return nil, err
-	}
 	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgBeginRedelegate(from, srcValAddr, dstValAddr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
-	if err != nil {
-		return nil, err
-	}
-	return ca.SubmitTx(ctx, signedTx)
+	return ca.submitMsg(ctx, account, func(from AccAddress) sdktypes.Msg {
+		return stakingtypes.NewMsgBeginRedelegate(from, srcValAddr, dstValAddr, coins)
+	}, fee, gasLim)
 }
 
 func (ca *CoreAccessor) Undelegate(
@@ -441,22 +719,28 @@ func (ca *CoreAccessor) Undelegate(
 	amount,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.UndelegateWithAccount(ctx, delAddr, amount, fee, gasLim, "")
+}
+
+// UndelegateWithAccount is Undelegate signed by the given account of the node's keyring, which is
+// either a key name or a bech32 address of a key, or by the default account if it is empty.
+func (ca *CoreAccessor) UndelegateWithAccount(
+	ctx context.Context,
+	delAddr ValAddress,
+	amount,
+	fee Int,
+	gasLim uint64,
+	account string,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
-	if err != nil {
-		return nil, err
-	}
 	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgUndelegate(from, delAddr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
-	if err != nil {
-		return nil, err
-	}
-	return ca.SubmitTx(ctx, signedTx)
+	return ca.submitMsg(ctx, account, func(from AccAddress) sdktypes.Msg {
+		return stakingtypes.NewMsgUndelegate(from, delAddr, coins)
+	}, fee, gasLim)
 }
 
 func (ca *CoreAccessor) Delegate(
@@ -465,22 +749,28 @@ func (ca *CoreAccessor) Delegate(
 	amount Int,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.DelegateWithAccount(ctx, delAddr, amount, fee, gasLim, "")
+}
+
+// DelegateWithAccount is Delegate signed by the given account of the node's keyring, which is
+// either a key name or a bech32 address of a key, or by the default account if it is empty.
+func (ca *CoreAccessor) DelegateWithAccount(
+	ctx context.Context,
+	delAddr ValAddress,
+	amount Int,
+	fee Int,
+	gasLim uint64,
+	account string,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
-	if err != nil {
-		return nil, err
-	}
 	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgDelegate(from, delAddr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
-	if err != nil {
-		return nil, err
-	}
-	return ca.SubmitTx(ctx, signedTx)
+	return ca.submitMsg(ctx, account, func(from AccAddress) sdktypes.Msg {
+		return stakingtypes.NewMsgDelegate(from, delAddr, coins)
+	}, fee, gasLim)
 }
 
 func (ca *CoreAccessor) QueryDelegation(
@@ -545,6 +835,31 @@ func (ca *CoreAccessor) markSuccessfulPFB() {
 	ca.payForBlobCount++
 }
 
+// addPendingPFB tracks the asynchronously submitted PayForBlob until it is committed. PayForBlobs
+// whose status is never requested are dropped after the pendingPFBTimeout.
+func (ca *CoreAccessor) addPendingPFB(txHash string) {
+	ca.lock.Lock()
+	defer ca.lock.Unlock()
+	now := time.Now()
+	for hash, submitted := range ca.pendingPFBs {
+		if now.Sub(submitted) > pendingPFBTimeout {
+			delete(ca.pendingPFBs, hash)
+		}
+	}
+	ca.pendingPFBs[strings.ToUpper(txHash)] = now
+}
+
+// commitPendingPFB stops tracking the committed transaction and reports whether it was an
+// asynchronously submitted PayForBlob.
+func (ca *CoreAccessor) commitPendingPFB(txHash string) bool {
+	ca.lock.Lock()
+	defer ca.lock.Unlock()
+	txHash = strings.ToUpper(txHash)
+	_, ok := ca.pendingPFBs[txHash]
+	delete(ca.pendingPFBs, txHash)
+	return ok
+}
+
 func (ca *CoreAccessor) setMinGasPrice(minGasPrice float64) {
 	ca.lock.Lock()
 	defer ca.lock.Unlock()
@@ -557,6 +872,23 @@ func (ca *CoreAccessor) getMinGasPrice() float64 {
 	return ca.minGasPrice
 }
 
+// SquareParams returns the maximum square size the chain currently allows, as set by the
+// governance and bound by the version of the app, along with the version of the app.
+func (ca *CoreAccessor) SquareParams(ctx context.Context) (int, uint64, error) {
+	info, err := ca.rpcCli.ABCIInfo(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("querying app version: %w", err)
+	}
+	appVersion := info.Response.AppVersion
+
+	resp, err := apptypes.NewQueryClient(ca.coreConn).Params(ctx, &apptypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, 0, fmt.Errorf("querying blob params: %w", err)
+	}
+	maxSquareSize := min(int(resp.Params.GovMaxSquareSize), appconsts.SquareSizeUpperBound(appVersion))
+	return maxSquareSize, appVersion, nil
+}
+
 // QueryMinimumGasPrice returns the minimum gas price required by the node.
 func (ca *CoreAccessor) queryMinimumGasPrice(
 	ctx context.Context,
@@ -2,7 +2,7 @@ package state
 
 import (
 	"context"
-	"errors"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"testing"
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
@@ -52,6 +53,10 @@ func TestSubmitPayForBlob(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, appconsts.DefaultMinGasPrice, minGas)
 
+	maxSquareSize, _, err := ca.SquareParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, appconsts.DefaultGovMaxSquareSize, maxSquareSize)
+
 	testcases := []struct {
 		name   string
 		blobs  []*blob.Blob
@@ -64,7 +69,7 @@ func TestSubmitPayForBlob(t *testing.T) {
 			blobs:  []*blob.Blob{},
 			fee:    sdktypes.ZeroInt(),
 			gasLim: 0,
-			expErr: errors.New("state: no blobs provided"),
+			expErr: ErrNoBlobs,
 		},
 		{
 			name:   "good blob with user provided gas and fees",
@@ -88,6 +93,134 @@ func TestSubmitPayForBlob(t *testing.T) {
 		})
 	}
 
+	t.Run("estimate fee", func(t *testing.T) {
+		blobs := []*blob.Blob{blobbyTheBlob}
+		gasLim, err := ca.EstimateGas(ctx, blobs)
+		require.NoError(t, err)
+		require.Equal(t, blobtypes.DefaultEstimateGas([]uint32{uint32(len(blobbyTheBlob.Data))}), gasLim)
+
+		estimate, err := ca.EstimateFee(ctx, blobs)
+		require.NoError(t, err)
+		require.Equal(t, gasLim, estimate.GasLimit)
+		require.Equal(t, appconsts.DefaultMinGasPrice, estimate.GasPrice)
+		require.True(t, estimate.Fee.GTE(sdktypes.NewInt(int64(estimate.GasPrice*float64(gasLim)))))
+
+		// the estimate is enough to pay for the blobs
+		resp, err := ca.SubmitPayForBlob(ctx, estimate.Fee, estimate.GasLimit, blobs)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+
+		_, err = ca.EstimateFee(ctx, nil)
+		require.ErrorIs(t, err, ErrNoBlobs)
+	})
+
+	t.Run("async blobs are counted once committed", func(t *testing.T) {
+		count := ca.PayForBlobCount()
+		resp, err := ca.SubmitPayForBlobAsync(ctx, sdktypes.NewInt(-1), 0, []*blob.Blob{blobbyTheBlob}, "")
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+		require.Equal(t, count, ca.PayForBlobCount())
+
+		require.Eventually(t, func() bool {
+			status, err := ca.TxStatus(ctx, resp.TxHash)
+			return err == nil && status != nil
+		}, time.Second*10, time.Millisecond*100)
+		require.Equal(t, count+1, ca.PayForBlobCount())
+
+		// later status requests don't count the blob again
+		_, err = ca.TxStatus(ctx, resp.TxHash)
+		require.NoError(t, err)
+		require.Equal(t, count+1, ca.PayForBlobCount())
+	})
+
+	t.Run("concurrent blobs from different accounts", func(t *testing.T) {
+		robAddr, err := cctx.Keyring.Key(accounts[1])
+		require.NoError(t, err)
+		addr, err := robAddr.GetAddress()
+		require.NoError(t, err)
+
+		// the accounts can be selected both by the key name and the address
+		selected := []string{"", accounts[0], addr.String(), accounts[1]}
+		errCh := make(chan error, len(selected))
+		for _, account := range selected {
+			go func(account string) {
+				resp, err := ca.SubmitPayForBlobWithAccount(ctx, sdktypes.NewInt(-1), 0, []*blob.Blob{blobbyTheBlob}, account)
+				if err == nil && resp.Code != 0 {
+					err = fmt.Errorf("unexpected code %d for account %s", resp.Code, account)
+				}
+				errCh <- err
+			}(account)
+		}
+		for range selected {
+			require.NoError(t, <-errCh)
+		}
+
+		_, err = ca.SubmitPayForBlobWithAccount(ctx, sdktypes.NewInt(-1), 0, []*blob.Blob{blobbyTheBlob}, "unknown")
+		require.Error(t, err)
+	})
+
+	t.Run("concurrent blobs from the same account", func(t *testing.T) {
+		const count = 5
+		errCh := make(chan error, count)
+		for i := 0; i < count; i++ {
+			go func() {
+				resp, err := ca.SubmitPayForBlob(ctx, sdktypes.NewInt(-1), 0, []*blob.Blob{blobbyTheBlob})
+				if err == nil && resp.Code != 0 {
+					err = fmt.Errorf("unexpected code %d", resp.Code)
+				}
+				errCh <- err
+			}()
+		}
+		for i := 0; i < count; i++ {
+			require.NoError(t, <-errCh)
+		}
+	})
+
+	t.Run("recovers from stale sequence", func(t *testing.T) {
+		signer, err := ca.accountSigner("")
+		require.NoError(t, err)
+		signer.SetSequence(0)
+
+		resp, err := ca.SubmitPayForBlob(ctx, sdktypes.NewInt(-1), 0, []*blob.Blob{blobbyTheBlob})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+	})
+
+	t.Run("rebroadcasts evicted transactions", func(t *testing.T) {
+		signer, err := ca.accountSigner("")
+		require.NoError(t, err)
+
+		// sign the transaction without broadcasting it, as if it was evicted from the mempool
+		gasLim := estimateGas([]*blob.Blob{blobbyTheBlob})
+		signer.lock.Lock()
+		signerData, err := signer.GetSignerData()
+		require.NoError(t, err)
+		rawTx, err := ca.constructPayForBlobTx(signer.KeyringSigner, []*blobtypes.Blob{&blobbyTheBlob.Blob},
+			blobtypes.SetGasLimit(gasLim), withFee(calculateFee(ca.getMinGasPrice(), gasLim)))
+		require.NoError(t, err)
+		signer.SetSequence(signerData.Sequence + 1)
+		signer.lock.Unlock()
+
+		evicted, err := ca.isEvicted(ctx, rawTx)
+		require.NoError(t, err)
+		require.True(t, evicted)
+
+		txHash := hex.EncodeToString(coretypes.Tx(rawTx).Hash())
+		resp, err := ca.waitForTx(ctx, txHash, &signedTx{raw: rawTx, sequence: signerData.Sequence})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+	})
+
+	t.Run("waits no longer than the commit timeout", func(t *testing.T) {
+		ca := NewCoreAccessor(signer, nil, "127.0.0.1", extractPort(rpcAddr), extractPort(grpcAddr),
+			WithTxCommitTimeout(time.Millisecond*100))
+		ca.coreConn, ca.rpcCli = cctx.GRPCClient, cctx.Client
+
+		start := time.Now()
+		_, err := ca.waitForTx(ctx, strings.Repeat("00", 32), &signedTx{})
+		require.Error(t, err)
+		require.Less(t, time.Since(start), time.Second)
+	})
 }
 
 func extractPort(addr string) string {
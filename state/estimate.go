@@ -0,0 +1,71 @@
+package state
+
+import (
+	"context"
+	"math"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+
+	"github.com/celestiaorg/celestia-node/blob"
+)
+
+// FeeEstimate is the estimated cost of submitting a PayForBlobs transaction.
+type FeeEstimate struct {
+	// GasLimit is the estimated amount of gas the transaction consumes.
+	GasLimit uint64 `json:"gas_limit"`
+	// GasPrice is the minimum gas price the node currently accepts, in utia.
+	GasPrice float64 `json:"gas_price"`
+	// Fee is the fee of the transaction, in utia: the gas limit multiplied by the gas price.
+	Fee Int `json:"fee"`
+}
+
+// EstimateGas estimates the gas limit of a PayForBlobs transaction of the given blobs.
+func (ca *CoreAccessor) EstimateGas(_ context.Context, blobs []*blob.Blob) (uint64, error) {
+	if len(blobs) == 0 {
+		return 0, ErrNoBlobs
+	}
+	return estimateGas(blobs), nil
+}
+
+// EstimateFee estimates the gas limit of a PayForBlobs transaction of the given blobs and the fee
+// to pay for it with the current minimum gas price of the node.
+func (ca *CoreAccessor) EstimateFee(ctx context.Context, blobs []*blob.Blob) (*FeeEstimate, error) {
+	if len(blobs) == 0 {
+		return nil, ErrNoBlobs
+	}
+
+	minGasPrice, err := ca.queryMinimumGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ca.setMinGasPrice(minGasPrice)
+
+	gasLim := estimateGas(blobs)
+	return &FeeEstimate{
+		GasLimit: gasLim,
+		GasPrice: minGasPrice,
+		Fee:      calculateFee(minGasPrice, gasLim),
+	}, nil
+}
+
+// estimateGas estimates the gas limit of a PayForBlobs transaction of the given blobs.
+func estimateGas(blobs []*blob.Blob) uint64 {
+	blobSizes := make([]uint32, len(blobs))
+	for i, blob := range blobs {
+		blobSizes[i] = uint32(len(blob.Data))
+	}
+
+	// TODO (@cmwaters): the default gas per byte and the default tx size cost per byte could be changed
+	// through governance. This section could be more robust by tracking these values and adjusting the
+	// gas limit accordingly (as is done for the gas price)
+	return apptypes.EstimateGas(blobSizes, appconsts.DefaultGasPerBlobByte, auth.DefaultTxSizeCostPerByte)
+}
+
+// calculateFee returns the fee for the given gas limit at the given gas price.
+func calculateFee(gasPrice float64, gasLim uint64) Int {
+	return sdktypes.NewInt(int64(math.Ceil(gasPrice * float64(gasLim))))
+}
@@ -60,7 +60,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	require.NoError(s.T(), err)
 }
 
-func setClients(ca *CoreAccessor, conn *grpc.ClientConn, abciCli rpcclient.ABCIClient) {
+func setClients(ca *CoreAccessor, conn *grpc.ClientConn, abciCli rpcclient.Client) {
 	ca.coreConn = conn
 	// create the query client
 	queryCli := banktypes.NewQueryClient(ca.coreConn)
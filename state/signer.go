@@ -0,0 +1,117 @@
+package state
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+
+	apperrors "github.com/celestiaorg/celestia-app/app/errors"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// maxSequenceRetries is the amount of times a transaction is re-signed after the local sequence
+// of the account doesn't match the one expected by the mempool.
+const maxSequenceRetries = 3
+
+// sequenceRetryDelay is the time to wait before re-signing a transaction whose sequence is ahead
+// of the one expected by the mempool. It is long enough for the evicted pending transactions of
+// the account to be broadcasted again.
+const sequenceRetryDelay = txPollInterval
+
+// accountSigner signs transactions of a single account of the keyring. It tracks the sequence of
+// the account locally, so that concurrent transactions of the account get consecutive sequences
+// and can be pipelined without waiting for the previous ones to be committed. Transactions of
+// different accounts don't block each other.
+type accountSigner struct {
+	*apptypes.KeyringSigner
+
+	// lock guards the sequence of the account from signing until the transaction is accepted by
+	// the mempool.
+	lock sync.Mutex
+	// sequenceLoaded is false until the account number and the sequence are queried from the
+	// chain, and after a broadcast with an unknown outcome.
+	sequenceLoaded bool
+}
+
+// signedTx is a raw transaction along with the sequence of the account it is signed with.
+type signedTx struct {
+	raw      []byte
+	sequence uint64
+}
+
+// broadcast signs the transaction built by the given function with the next sequence of the
+// account and broadcasts it in the sync mode, i.e. it returns once the transaction passes CheckTx
+// and enters the mempool. If the local sequence doesn't match the one expected by the mempool, the
+// transaction is re-signed. The signed transaction is returned along with the response.
+func (s *accountSigner) broadcast(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	buildTx func(*apptypes.KeyringSigner) ([]byte, error),
+) (*TxResponse, *signedTx, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		if !s.sequenceLoaded {
+			if err := s.QueryAccountNumber(ctx, conn); err != nil {
+				return nil, nil, err
+			}
+			s.sequenceLoaded = true
+		}
+
+		signerData, err := s.GetSignerData()
+		if err != nil {
+			return nil, nil, err
+		}
+		rawTx, err := buildTx(s.KeyringSigner)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := apptypes.BroadcastTx(ctx, conn, sdktx.BroadcastMode_BROADCAST_MODE_SYNC, rawTx)
+		if err != nil {
+			// it is unknown whether the transaction reached the mempool, so the sequence has to be
+			// re-queried for the next one
+			s.sequenceLoaded = false
+			return nil, nil, err
+		}
+
+		txResp := resp.TxResponse
+		if txResp.Code == 0 {
+			s.SetSequence(signerData.Sequence + 1)
+			return txResp, &signedTx{raw: rawTx, sequence: signerData.Sequence}, nil
+		}
+
+		err = sdkerrors.ABCIError(txResp.Codespace, txResp.Code, txResp.RawLog)
+		if !apperrors.IsNonceMismatch(err) || attempt >= maxSequenceRetries {
+			return txResp, &signedTx{raw: rawTx, sequence: signerData.Sequence}, nil
+		}
+		expected, err := apperrors.ParseNonceMismatch(err)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Warnw("account sequence mismatch, re-signing transaction", "account", s.GetSignerInfo().Name,
+			"sequence", signerData.Sequence, "expected", expected, "attempt", attempt+1)
+
+		switch {
+		case expected > signerData.Sequence:
+			// the account was used by another client, so the local sequence is behind
+			s.SetSequence(expected)
+		case attempt < maxSequenceRetries-1:
+			// the pending transactions of the account may be evicted from the mempool and not yet
+			// broadcasted again, so the expected sequence is temporarily behind the local one
+			select {
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			case <-time.After(sequenceRetryDelay):
+			}
+		default:
+			// the pending transactions of the account were dropped for good
+			s.SetSequence(expected)
+		}
+	}
+}